@@ -3,6 +3,8 @@ package client
 import (
 	"context"
 	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type UploadCustomCertificateRequest struct {
@@ -13,7 +15,11 @@ type UploadCustomCertificateRequest struct {
 }
 
 type CertificateResponse struct {
-	ID string `json:"id"`
+	ID        string   `json:"id"`
+	CNs       []string `json:"cns"`
+	CreatedAt int64    `json:"createdAt"`
+	ExpiresAt int64    `json:"expiresAt"`
+	AutoRenew bool     `json:"autoRenew"`
 }
 
 func (c *Client) UploadCustomCertificate(ctx context.Context, request UploadCustomCertificateRequest) (cr CertificateResponse, err error) {
@@ -71,3 +77,51 @@ func (c *Client) DeleteCustomCertificate(ctx context.Context, request DeleteCust
 	}, nil)
 	return err
 }
+
+// ListCertificates lists all the certificates for a team, including those issued automatically by Vercel,
+// requesting each page of results in turn.
+func (c *Client) ListCertificates(ctx context.Context, teamID string) (certs []CertificateResponse, err error) {
+	baseURL := fmt.Sprintf("%s/v5/now/certs?limit=100", c.baseURL)
+	if c.TeamID(teamID) != "" {
+		baseURL = fmt.Sprintf("%s&teamId=%s", baseURL, c.TeamID(teamID))
+	}
+
+	url := baseURL
+	for {
+		var response struct {
+			Certs []struct {
+				UID        string   `json:"uid"`
+				CNs        []string `json:"cns"`
+				Created    int64    `json:"created"`
+				Expiration int64    `json:"expiration"`
+				AutoRenew  bool     `json:"autoRenew"`
+			} `json:"certs"`
+			Pagination pagination `json:"pagination"`
+		}
+		tflog.Info(ctx, "listing certificates", map[string]any{
+			"url": url,
+		})
+		err = c.doRequest(clientRequest{
+			ctx:    ctx,
+			method: "GET",
+			url:    url,
+			body:   "",
+		}, &response)
+		if err != nil {
+			return certs, err
+		}
+		for _, cert := range response.Certs {
+			certs = append(certs, CertificateResponse{
+				ID:        cert.UID,
+				CNs:       cert.CNs,
+				CreatedAt: cert.Created,
+				ExpiresAt: cert.Expiration,
+				AutoRenew: cert.AutoRenew,
+			})
+		}
+		if response.Pagination.Next == nil || len(response.Certs) == 0 {
+			return certs, nil
+		}
+		url = fmt.Sprintf("%s&until=%d", baseURL, *response.Pagination.Next)
+	}
+}
@@ -136,3 +136,30 @@ func (c *Client) DeleteCustomEnvironment(ctx context.Context, request DeleteCust
 	}, nil)
 	return err
 }
+
+// ListCustomEnvironments lists all the custom environments of a project.
+func (c *Client) ListCustomEnvironments(ctx context.Context, projectID, teamID string) (res []CustomEnvironmentResponse, err error) {
+	url := fmt.Sprintf("%s/v9/projects/%s/custom-environments", c.baseURL, projectID)
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
+	}
+	tflog.Info(ctx, "listing custom environments", map[string]any{
+		"url": url,
+	})
+	var r struct {
+		Environments []CustomEnvironmentResponse `json:"environments"`
+	}
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+	}, &r)
+	if err != nil {
+		return nil, err
+	}
+	for i := range r.Environments {
+		r.Environments[i].TeamID = c.TeamID(teamID)
+		r.Environments[i].ProjectID = projectID
+	}
+	return r.Environments, nil
+}
@@ -3,7 +3,10 @@ package client
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"slices"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -55,6 +58,80 @@ func (c *Client) CreateDeployHook(ctx context.Context, request CreateDeployHookR
 	return h, fmt.Errorf("deploy hook was created successfully, but could not be found")
 }
 
+type GetDeployHookRequest struct {
+	ProjectID string
+	TeamID    string
+	ID        string
+}
+
+// GetDeployHook returns a single deploy hook of a project. Deploy hooks can only be read as part of
+// the project they belong to.
+func (c *Client) GetDeployHook(ctx context.Context, request GetDeployHookRequest) (h DeployHook, err error) {
+	r, err := c.GetProject(ctx, request.ProjectID, request.TeamID)
+	if err != nil {
+		return h, err
+	}
+	if r.Link != nil {
+		for _, hook := range r.Link.DeployHooks {
+			if hook.ID == request.ID {
+				return hook, nil
+			}
+		}
+	}
+	return h, APIError{
+		StatusCode: 404,
+		Message:    "Deploy Hook not found",
+		Code:       "not_found",
+	}
+}
+
+// DeployHookJob is the deployment job that is queued when a deploy hook is triggered.
+type DeployHookJob struct {
+	ID        string `json:"id"`
+	State     string `json:"state"`
+	CreatedAt int64  `json:"createdAt"`
+}
+
+// deployHookPath is the path every deploy hook URL starts with.
+const deployHookPath = "/v1/integrations/deploy/"
+
+// ValidateDeployHookURL checks that a URL is a Vercel deploy hook URL, so that triggering it cannot send a
+// request to an arbitrary host.
+func (c *Client) ValidateDeployHookURL(url string) error {
+	if strings.HasPrefix(url, "https://api.vercel.com"+deployHookPath) || strings.HasPrefix(url, c.baseURL+deployHookPath) {
+		return nil
+	}
+	return fmt.Errorf("expected a deploy hook URL starting with https://api.vercel.com%s, but got %s", deployHookPath, url)
+}
+
+// TriggerDeployHook makes a POST request to a deploy hook URL, which queues a new deployment.
+// The deploy hook URL contains its own secret, so the request is sent without the API token.
+func (c *Client) TriggerDeployHook(ctx context.Context, url string) (j DeployHookJob, err error) {
+	if err := c.ValidateDeployHookURL(url); err != nil {
+		return j, err
+	}
+	tflog.Info(ctx, "triggering deploy hook", map[string]any{})
+
+	req := clientRequest{
+		ctx:    ctx,
+		method: "POST",
+		url:    url,
+		body:   "",
+	}
+	r, err := req.toHTTPRequest()
+	if err != nil {
+		return j, err
+	}
+	var response struct {
+		Job DeployHookJob `json:"job"`
+	}
+	err = doHTTPRequest(&http.Client{Timeout: 60 * time.Second}, r, &response, false)
+	if err != nil {
+		return j, fmt.Errorf("error triggering deploy hook: %w", err)
+	}
+	return response.Job, nil
+}
+
 type DeleteDeployHookRequest struct {
 	ProjectID string
 	TeamID    string
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTriggerDeployHook(t *testing.T) {
+	h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Errorf("expected no Authorization header, but got %s", auth)
+		}
+		if r.Method != "POST" {
+			t.Errorf("expected a POST request, but got %s", r.Method)
+		}
+		fmt.Fprintln(w, `{ "job": { "id": "job_123", "state": "PENDING", "createdAt": 1 } }`)
+	}))
+	defer h.Close()
+	cl := New("SECRET_TOKEN")
+	cl.baseURL = h.URL
+
+	job, err := cl.TriggerDeployHook(context.Background(), h.URL+"/v1/integrations/deploy/prj_123/abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.ID != "job_123" || job.State != "PENDING" {
+		t.Errorf("unexpected job %+v", job)
+	}
+}
+
+func TestValidateDeployHookURL(t *testing.T) {
+	cl := New("SECRET_TOKEN")
+	for _, tc := range []struct {
+		URL   string
+		Valid bool
+	}{
+		{URL: "https://api.vercel.com/v1/integrations/deploy/prj_123/abc", Valid: true},
+		{URL: "https://example.com/v1/integrations/deploy/prj_123/abc"},
+		{URL: "https://api.vercel.com.example.com/v1/integrations/deploy/prj_123/abc"},
+		{URL: "http://api.vercel.com/v1/integrations/deploy/prj_123/abc"},
+		{URL: "https://api.vercel.com/v9/projects/prj_123"},
+	} {
+		t.Run(tc.URL, func(t *testing.T) {
+			err := cl.ValidateDeployHookURL(tc.URL)
+			if tc.Valid && err != nil {
+				t.Errorf("expected the URL to be valid, but got %s", err)
+			}
+			if !tc.Valid && err == nil {
+				t.Error("expected the URL to be invalid")
+			}
+		})
+	}
+}
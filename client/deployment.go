@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
@@ -18,6 +19,7 @@ type DeploymentFile struct {
 	File string `json:"file"`
 	Sha  string `json:"sha"`
 	Size int    `json:"size"`
+	Mode int    `json:"mode,omitempty"`
 }
 
 type gitSource struct {
@@ -168,6 +170,43 @@ func (e MissingFilesError) Error() string {
 	return fmt.Sprintf("%s - %s", e.Code, e.Message)
 }
 
+// ListDeploymentBranches returns the git branches of a project's most recent deployments, sorted by name.
+func (c *Client) ListDeploymentBranches(ctx context.Context, projectID, teamID string) ([]string, error) {
+	url := fmt.Sprintf("%s/v6/deployments?projectId=%s&limit=100", c.baseURL, projectID)
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s&teamId=%s", url, c.TeamID(teamID))
+	}
+	tflog.Info(ctx, "listing deployment branches", map[string]any{
+		"url": url,
+	})
+	var r struct {
+		Deployments []struct {
+			Meta map[string]string `json:"meta"`
+		} `json:"deployments"`
+	}
+	err := c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+	}, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	branches := []string{}
+	for _, d := range r.Deployments {
+		for _, key := range []string{"githubCommitRef", "gitlabCommitRef", "bitbucketCommitRef"} {
+			if b := d.Meta[key]; b != "" && !seen[b] {
+				seen[b] = true
+				branches = append(branches, b)
+			}
+		}
+	}
+	sort.Strings(branches)
+	return branches, nil
+}
+
 func (c *Client) getGitSource(ctx context.Context, projectID, ref, teamID string) (gs gitSource, err error) {
 	project, err := c.GetProject(ctx, projectID, teamID)
 	if err != nil {
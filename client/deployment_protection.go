@@ -25,6 +25,7 @@ type TrustedIps struct {
 
 type ProtectionBypass struct {
 	Scope string `json:"scope"`
+	Note  string `json:"note,omitempty"`
 }
 
 type OptionsAllowlist struct {
@@ -15,12 +15,20 @@ type SRV struct {
 	Weight   int64  `json:"weight"`
 }
 
+// HTTPS defines the metadata required for creating an HTTPS type DNS Record.
+type HTTPS struct {
+	Priority int64  `json:"priority"`
+	Target   string `json:"target"`
+	Params   string `json:"params,omitempty"`
+}
+
 // CreateDNSRecordRequest defines the information necessary to create a DNS record within Vercel.
 type CreateDNSRecordRequest struct {
 	Domain     string `json:"-"`
 	MXPriority int64  `json:"mxPriority,omitempty"`
 	Name       string `json:"name"`
 	SRV        *SRV   `json:"srv,omitempty"`
+	HTTPS      *HTTPS `json:"https,omitempty"`
 	TTL        int64  `json:"ttl,omitempty"`
 	Type       string `json:"type"`
 	Value      string `json:"value,omitempty"`
@@ -96,29 +104,41 @@ func (c *Client) GetDNSRecord(ctx context.Context, recordID, teamID string) (r D
 	return r, err
 }
 
-// ListDNSRecords is a test helper for listing DNS records that exist for a given domain.
-// We limit this to 100, as this is the largest limit allowed by the API.
-// This is only used by the sweeper script, so this is safe to do so, but converting
-// into a production ready function would require some refactoring.
+// ListDNSRecords lists all the DNS records that exist for a given domain, requesting each page
+// of results in turn.
 func (c *Client) ListDNSRecords(ctx context.Context, domain, teamID string) (r []DNSRecord, err error) {
-	url := fmt.Sprintf("%s/v4/domains/%s/records?limit=100", c.baseURL, domain)
+	baseURL := fmt.Sprintf("%s/v4/domains/%s/records?limit=100", c.baseURL, domain)
 	if c.TeamID(teamID) != "" {
-		url = fmt.Sprintf("%s&teamId=%s", url, c.TeamID(teamID))
+		baseURL = fmt.Sprintf("%s&teamId=%s", baseURL, c.TeamID(teamID))
 	}
 
-	dr := struct {
-		Records []DNSRecord `json:"records"`
-	}{}
-	err = c.doRequest(clientRequest{
-		ctx:    ctx,
-		method: "GET",
-		url:    url,
-		body:   "",
-	}, &dr)
-	for i := 0; i < len(dr.Records); i++ {
-		dr.Records[i].TeamID = c.TeamID(teamID)
+	url := baseURL
+	for {
+		dr := struct {
+			Records    []DNSRecord `json:"records"`
+			Pagination pagination  `json:"pagination"`
+		}{}
+		tflog.Info(ctx, "listing dns records", map[string]any{
+			"url": url,
+		})
+		err = c.doRequest(clientRequest{
+			ctx:    ctx,
+			method: "GET",
+			url:    url,
+			body:   "",
+		}, &dr)
+		if err != nil {
+			return r, err
+		}
+		for i := 0; i < len(dr.Records); i++ {
+			dr.Records[i].TeamID = c.TeamID(teamID)
+		}
+		r = append(r, dr.Records...)
+		if dr.Pagination.Next == nil || len(dr.Records) == 0 {
+			return r, nil
+		}
+		url = fmt.Sprintf("%s&until=%d", baseURL, *dr.Pagination.Next)
 	}
-	return dr.Records, err
 }
 
 // SRVUpdate defines the updatable fields within an SRV block of a DNS record.
@@ -134,6 +154,7 @@ type UpdateDNSRecordRequest struct {
 	MXPriority *int64     `json:"mxPriority,omitempty"`
 	Name       *string    `json:"name,omitempty"`
 	SRV        *SRVUpdate `json:"srv,omitempty"`
+	HTTPS      *HTTPS     `json:"https,omitempty"`
 	TTL        *int64     `json:"ttl,omitempty"`
 	Value      *string    `json:"value,omitempty"`
 	Comment    string     `json:"comment"`
@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Domain is the information Vercel surfaces about a domain that has been added to an account.
+type Domain struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	TeamID      string `json:"-"`
+	ServiceType string `json:"serviceType"`
+	Verified    bool   `json:"verified"`
+}
+
+// ListDomains lists all the domains that have been added to an account, requesting each page of
+// results in turn.
+func (c *Client) ListDomains(ctx context.Context, teamID string) (r []Domain, err error) {
+	baseURL := fmt.Sprintf("%s/v5/domains?limit=100", c.baseURL)
+	if c.TeamID(teamID) != "" {
+		baseURL = fmt.Sprintf("%s&teamId=%s", baseURL, c.TeamID(teamID))
+	}
+
+	url := baseURL
+	for {
+		dr := struct {
+			Domains    []Domain   `json:"domains"`
+			Pagination pagination `json:"pagination"`
+		}{}
+		tflog.Info(ctx, "listing domains", map[string]any{
+			"url": url,
+		})
+		err = c.doRequest(clientRequest{
+			ctx:    ctx,
+			method: "GET",
+			url:    url,
+			body:   "",
+		}, &dr)
+		if err != nil {
+			return r, err
+		}
+		for i := range dr.Domains {
+			dr.Domains[i].TeamID = c.TeamID(teamID)
+		}
+		r = append(r, dr.Domains...)
+		if dr.Pagination.Next == nil || len(dr.Domains) == 0 {
+			return r, nil
+		}
+		url = fmt.Sprintf("%s&until=%d", baseURL, *dr.Pagination.Next)
+	}
+}
+
+// DomainResponse is the information Vercel surfaces about a single domain, including its nameservers and
+// how it can be verified.
+type DomainResponse struct {
+	ID                  string   `json:"id"`
+	Name                string   `json:"name"`
+	TeamID              string   `json:"-"`
+	ServiceType         string   `json:"serviceType"`
+	Verified            bool     `json:"verified"`
+	VerificationRecord  string   `json:"verificationRecord"`
+	Nameservers         []string `json:"nameservers"`
+	IntendedNameservers []string `json:"intendedNameservers"`
+	CustomNameservers   []string `json:"customNameservers"`
+}
+
+// UsesVercelDNS reports whether the domain's DNS is served by Vercel's nameservers.
+func (d DomainResponse) UsesVercelDNS() bool {
+	return d.ServiceType == "zeit.world"
+}
+
+// CreateDomain adds an existing domain to an account, so that it can be used by projects and have its DNS
+// managed by Vercel.
+func (c *Client) CreateDomain(ctx context.Context, name, teamID string) (r DomainResponse, err error) {
+	url := fmt.Sprintf("%s/v7/domains", c.baseURL)
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
+	}
+
+	payload := string(mustMarshal(struct {
+		Name   string `json:"name"`
+		Method string `json:"method"`
+	}{
+		Name:   name,
+		Method: "add",
+	}))
+	tflog.Info(ctx, "creating domain", map[string]any{
+		"url":     url,
+		"payload": payload,
+	})
+	var dr struct {
+		Domain DomainResponse `json:"domain"`
+	}
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "POST",
+		url:    url,
+		body:   payload,
+	}, &dr)
+	if err != nil {
+		return r, err
+	}
+	dr.Domain.TeamID = c.TeamID(teamID)
+	return dr.Domain, nil
+}
+
+// GetDomain retrieves information about a domain that has been added to an account.
+func (c *Client) GetDomain(ctx context.Context, name, teamID string) (r DomainResponse, err error) {
+	url := fmt.Sprintf("%s/v5/domains/%s", c.baseURL, name)
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
+	}
+
+	tflog.Info(ctx, "getting domain", map[string]any{
+		"url": url,
+	})
+	var dr struct {
+		Domain DomainResponse `json:"domain"`
+	}
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+		body:   "",
+	}, &dr)
+	if err != nil {
+		return r, err
+	}
+	dr.Domain.TeamID = c.TeamID(teamID)
+	return dr.Domain, nil
+}
+
+// DeleteDomain removes a domain from an account.
+func (c *Client) DeleteDomain(ctx context.Context, name, teamID string) error {
+	url := fmt.Sprintf("%s/v6/domains/%s", c.baseURL, name)
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
+	}
+
+	tflog.Info(ctx, "deleting domain", map[string]any{
+		"url": url,
+	})
+	return c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "DELETE",
+		url:    url,
+		body:   "",
+	}, nil)
+}
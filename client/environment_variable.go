@@ -127,11 +127,33 @@ func overlaps(s []string, e []string) bool {
 	return false
 }
 
+// FindConflictingEnvironmentVariables returns the environment variables that conflict with an
+// environment variable with the given key, targets, custom environments and git branch. Two
+// environment variables conflict when they share a key and git branch, and are both present on
+// at least one of the same environments.
+func FindConflictingEnvironmentVariables(envs []EnvironmentVariable, key string, target, customEnvironmentIDs []string, gitBranch *string) []EnvironmentVariable {
+	var conflicts []EnvironmentVariable
+	for _, env := range envs {
+		if env.Key != key || derefString(env.GitBranch) != derefString(gitBranch) {
+			continue
+		}
+		if !overlaps(env.Target, target) && !overlaps(env.CustomEnvironmentIDs, customEnvironmentIDs) {
+			continue
+		}
+		conflicts = append(conflicts, env)
+	}
+	return conflicts
+}
+
 func findConflictingEnvID(teamID, projectID string, envConflict EnvConflictError, envs []EnvironmentVariable) (string, bool) {
 	checkTargetOverlap := len(envConflict.Target) != 0
+	key := envConflict.EnvVarKey
+	if key == "" {
+		key = envConflict.Key
+	}
 
 	for _, env := range envs {
-		if env.Key != envConflict.EnvVarKey || env.GitBranch != envConflict.GitBranch {
+		if env.Key != key || derefString(env.GitBranch) != derefString(envConflict.GitBranch) {
 			continue
 		}
 
@@ -360,7 +382,18 @@ func (c *Client) DeleteEnvironmentVariable(ctx context.Context, projectID, teamI
 }
 
 func (c *Client) GetEnvironmentVariables(ctx context.Context, projectID, teamID string) ([]EnvironmentVariable, error) {
-	url := fmt.Sprintf("%s/v8/projects/%s/env?decrypt=true", c.baseURL, projectID)
+	return c.listEnvironmentVariables(ctx, projectID, teamID, true)
+}
+
+// ListEnvironmentVariables lists the environment variables of a project without decrypting them, so the
+// value of any sensitive or encrypted environment variable is not returned. It should be used whenever
+// only the key, target or other metadata of the environment variables is needed.
+func (c *Client) ListEnvironmentVariables(ctx context.Context, projectID, teamID string) ([]EnvironmentVariable, error) {
+	return c.listEnvironmentVariables(ctx, projectID, teamID, false)
+}
+
+func (c *Client) listEnvironmentVariables(ctx context.Context, projectID, teamID string, decrypt bool) ([]EnvironmentVariable, error) {
+	url := fmt.Sprintf("%s/v8/projects/%s/env?decrypt=%t", c.baseURL, projectID, decrypt)
 	if c.TeamID(teamID) != "" {
 		url = fmt.Sprintf("%s&teamId=%s", url, c.TeamID(teamID))
 	}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestFindConflictingEnvironmentVariables(t *testing.T) {
+	staging := "staging"
+	envs := []EnvironmentVariable{
+		{ID: "env_1", Key: "FOO", Target: []string{"production"}},
+		{ID: "env_2", Key: "FOO", Target: []string{"preview"}, GitBranch: &staging},
+		{ID: "env_3", Key: "FOO", CustomEnvironmentIDs: []string{"env_custom"}},
+		{ID: "env_4", Key: "BAR", Target: []string{"production", "preview"}},
+	}
+
+	for _, tc := range []struct {
+		name                 string
+		key                  string
+		target               []string
+		customEnvironmentIDs []string
+		gitBranch            *string
+		want                 []string
+	}{
+		{name: "overlapping target", key: "FOO", target: []string{"production", "development"}, want: []string{"env_1"}},
+		{name: "different target", key: "FOO", target: []string{"preview"}, want: nil},
+		{name: "same git branch", key: "FOO", target: []string{"preview"}, gitBranch: &staging, want: []string{"env_2"}},
+		{name: "custom environment", key: "FOO", customEnvironmentIDs: []string{"env_custom"}, want: []string{"env_3"}},
+		{name: "different key", key: "BAZ", target: []string{"production"}, want: nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, e := range FindConflictingEnvironmentVariables(envs, tc.key, tc.target, tc.customEnvironmentIDs, tc.gitBranch) {
+				got = append(got, e.ID)
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("got conflicts %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFindConflictingEnvID(t *testing.T) {
+	staging := "staging"
+	envs := []EnvironmentVariable{
+		{ID: "env_1", Key: "FOO", Target: []string{"preview"}, GitBranch: &staging},
+	}
+
+	// The API may return the key as either `key` or `envVarKey`.
+	branch := "staging"
+	id, ok := findConflictingEnvID("team_1", "prj_1", EnvConflictError{Key: "FOO", Target: []string{"preview"}, GitBranch: &branch}, envs)
+	if !ok || id != "team_1/prj_1/env_1" {
+		t.Errorf("got %q, %t, want team_1/prj_1/env_1", id, ok)
+	}
+}
+
+func TestListEnvironmentVariables(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		list    func(c *Client) ([]EnvironmentVariable, error)
+		decrypt string
+	}{
+		{
+			name: "list",
+			list: func(c *Client) ([]EnvironmentVariable, error) {
+				return c.ListEnvironmentVariables(context.Background(), "prj_123", "team_123")
+			},
+			decrypt: "false",
+		},
+		{
+			name: "get",
+			list: func(c *Client) ([]EnvironmentVariable, error) {
+				return c.GetEnvironmentVariables(context.Background(), "prj_123", "team_123")
+			},
+			decrypt: "true",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if decrypt := r.URL.Query().Get("decrypt"); decrypt != tc.decrypt {
+					t.Errorf("expected decrypt=%s, but got decrypt=%s", tc.decrypt, decrypt)
+				}
+				fmt.Fprintln(w, `{ "envs": [{ "id": "env_1", "key": "FOO" }] }`)
+			}))
+			defer h.Close()
+			cl := New("SECRET_TOKEN")
+			cl.baseURL = h.URL
+
+			envs, err := tc.list(cl)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(envs) != 1 || envs[0].ID != "env_1" || envs[0].TeamID != "team_123" {
+				t.Errorf("unexpected environment variables %+v", envs)
+			}
+		})
+	}
+}
@@ -218,8 +218,17 @@ type ProjectResponse struct {
 }
 
 type ProjectCronsResponse struct {
-	DisabledAt *int `json:"disabledAt"`
-	EnabledAt  int  `json:"enabled"`
+	DisabledAt   *int             `json:"disabledAt"`
+	EnabledAt    int              `json:"enabled"`
+	DeploymentID *string          `json:"deploymentId"`
+	Definitions  []CronDefinition `json:"definitions"`
+}
+
+// CronDefinition is a single cron job, as defined in the `crons` field of vercel.json.
+type CronDefinition struct {
+	Host     string `json:"host"`
+	Path     string `json:"path"`
+	Schedule string `json:"schedule"`
 }
 
 type GitComments struct {
@@ -284,29 +293,40 @@ func (c *Client) GetProject(ctx context.Context, projectID, teamID string) (r Pr
 	return r, err
 }
 
-// ListProjects lists the top 100 projects (no pagination) from within Vercel.
+// ListProjects lists all the projects within Vercel, requesting each page of results in turn.
 func (c *Client) ListProjects(ctx context.Context, teamID string) (r []ProjectResponse, err error) {
-	url := fmt.Sprintf("%s/v8/projects?limit=100", c.baseURL)
+	baseURL := fmt.Sprintf("%s/v8/projects?limit=100", c.baseURL)
 	if c.TeamID(teamID) != "" {
-		url = fmt.Sprintf("%s&teamId=%s", url, c.TeamID(teamID))
+		baseURL = fmt.Sprintf("%s&teamId=%s", baseURL, c.TeamID(teamID))
 	}
 
-	pr := struct {
-		Projects []ProjectResponse `json:"projects"`
-	}{}
-	tflog.Info(ctx, "listing projects", map[string]any{
-		"url": url,
-	})
-	err = c.doRequest(clientRequest{
-		ctx:    ctx,
-		method: "GET",
-		url:    url,
-		body:   "",
-	}, &pr)
-	for i := range pr.Projects {
-		pr.Projects[i].TeamID = c.TeamID(teamID)
+	url := baseURL
+	for {
+		pr := struct {
+			Projects   []ProjectResponse `json:"projects"`
+			Pagination pagination        `json:"pagination"`
+		}{}
+		tflog.Info(ctx, "listing projects", map[string]any{
+			"url": url,
+		})
+		err = c.doRequest(clientRequest{
+			ctx:    ctx,
+			method: "GET",
+			url:    url,
+			body:   "",
+		}, &pr)
+		if err != nil {
+			return r, err
+		}
+		for i := range pr.Projects {
+			pr.Projects[i].TeamID = c.TeamID(teamID)
+		}
+		r = append(r, pr.Projects...)
+		if pr.Pagination.Next == nil || len(pr.Projects) == 0 {
+			return r, nil
+		}
+		url = fmt.Sprintf("%s&until=%d", baseURL, *pr.Pagination.Next)
 	}
-	return pr.Projects, err
 }
 
 // UpdateProjectRequest defines the possible fields that can be updated within a vercel project.
@@ -49,3 +49,27 @@ func (c *Client) UpdateProjectCrons(ctx context.Context, request ProjectCrons) (
 		Enabled:   r.Crons == nil || r.Crons.DisabledAt == nil,
 	}, err
 }
+
+// ProjectCronDefinitions are the cron jobs of a project's current production deployment.
+type ProjectCronDefinitions struct {
+	ProjectID    string
+	TeamID       string
+	DeploymentID string
+	Definitions  []CronDefinition
+}
+
+// GetProjectCronDefinitions retrieves the cron jobs Vercel reports for a project's current production deployment.
+func (c *Client) GetProjectCronDefinitions(ctx context.Context, projectID, teamID string) (ProjectCronDefinitions, error) {
+	r, err := c.GetProject(ctx, projectID, teamID)
+	d := ProjectCronDefinitions{
+		ProjectID: projectID,
+		TeamID:    c.TeamID(teamID),
+	}
+	if r.Crons != nil {
+		if r.Crons.DeploymentID != nil {
+			d.DeploymentID = *r.Crons.DeploymentID
+		}
+		d.Definitions = r.Crons.Definitions
+	}
+	return d, err
+}
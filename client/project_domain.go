@@ -69,6 +69,18 @@ type ProjectDomainResponse struct {
 	RedirectStatusCode  *int64  `json:"redirectStatusCode"`
 	GitBranch           *string `json:"gitBranch"`
 	CustomEnvironmentID *string `json:"customEnvironmentId"`
+	Verified            bool    `json:"verified"`
+	// Verification lists the DNS records that prove ownership of the domain. It is only present while
+	// the domain is unverified, which happens when the domain is in use by another Vercel account.
+	Verification []ProjectDomainVerification `json:"verification"`
+}
+
+// ProjectDomainVerification is a DNS record that must be added to verify ownership of a project domain.
+type ProjectDomainVerification struct {
+	Type   string `json:"type"`
+	Domain string `json:"domain"`
+	Value  string `json:"value"`
+	Reason string `json:"reason"`
 }
 
 // GetProjectDomain retrieves information about a project domain from Vercel.
@@ -91,6 +103,43 @@ func (c *Client) GetProjectDomain(ctx context.Context, projectID, domain, teamID
 	return r, err
 }
 
+// ListProjectDomains lists all the domains associated with a project, requesting each page of
+// results in turn.
+func (c *Client) ListProjectDomains(ctx context.Context, projectID, teamID string) (r []ProjectDomainResponse, err error) {
+	baseURL := fmt.Sprintf("%s/v9/projects/%s/domains?limit=100", c.baseURL, projectID)
+	if c.TeamID(teamID) != "" {
+		baseURL = fmt.Sprintf("%s&teamId=%s", baseURL, c.TeamID(teamID))
+	}
+
+	url := baseURL
+	for {
+		dr := struct {
+			Domains    []ProjectDomainResponse `json:"domains"`
+			Pagination pagination              `json:"pagination"`
+		}{}
+		tflog.Info(ctx, "listing project domains", map[string]any{
+			"url": url,
+		})
+		err = c.doRequest(clientRequest{
+			ctx:    ctx,
+			method: "GET",
+			url:    url,
+			body:   "",
+		}, &dr)
+		if err != nil {
+			return r, err
+		}
+		for i := range dr.Domains {
+			dr.Domains[i].TeamID = c.TeamID(teamID)
+		}
+		r = append(r, dr.Domains...)
+		if dr.Pagination.Next == nil || len(dr.Domains) == 0 {
+			return r, nil
+		}
+		url = fmt.Sprintf("%s&until=%d", baseURL, *dr.Pagination.Next)
+	}
+}
+
 // UpdateProjectDomainRequest defines the information necessary to update a project domain.
 type UpdateProjectDomainRequest struct {
 	GitBranch           *string `json:"gitBranch"`
@@ -120,3 +169,25 @@ func (c *Client) UpdateProjectDomain(ctx context.Context, projectID, domain, tea
 	r.TeamID = c.TeamID(teamID)
 	return r, err
 }
+
+// VerifyProjectDomain asks Vercel to check the verification records of a project domain, and returns the
+// project domain with its updated verification status. Vercel returns an error if the domain could not be
+// verified.
+func (c *Client) VerifyProjectDomain(ctx context.Context, projectID, domain, teamID string) (r ProjectDomainResponse, err error) {
+	url := fmt.Sprintf("%s/v9/projects/%s/domains/%s/verify", c.baseURL, projectID, domain)
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
+	}
+
+	tflog.Info(ctx, "verifying project domain", map[string]any{
+		"url": url,
+	})
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "POST",
+		url:    url,
+		body:   "",
+	}, &r)
+	r.TeamID = c.TeamID(teamID)
+	return r, err
+}
@@ -0,0 +1,171 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ProtectionBypassSecret is a single Protection Bypass for Automation secret of a project.
+type ProtectionBypassSecret struct {
+	TeamID    string
+	ProjectID string
+	Secret    string
+	Note      string
+}
+
+type CreateProtectionBypassRequest struct {
+	TeamID    string
+	ProjectID string
+	Secret    string
+	Note      string
+}
+
+type UpdateProtectionBypassRequest struct {
+	TeamID    string
+	ProjectID string
+	Secret    string
+	Note      string
+}
+
+type DeleteProtectionBypassRequest struct {
+	TeamID    string
+	ProjectID string
+	Secret    string
+}
+
+type protectionBypassSecretRequest struct {
+	Secret string  `json:"secret,omitempty"`
+	Note   *string `json:"note,omitempty"`
+}
+
+func (c *Client) patchProtectionBypass(ctx context.Context, projectID, teamID, payload string) (map[string]ProtectionBypass, error) {
+	url := fmt.Sprintf("%s/v10/projects/%s/protection-bypass", c.baseURL, projectID)
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
+	}
+	tflog.Info(ctx, "updating protection bypass", map[string]any{
+		"url": url,
+	})
+	response := struct {
+		ProtectionBypass map[string]ProtectionBypass `json:"protectionBypass"`
+	}{}
+	err := c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "PATCH",
+		url:    url,
+		body:   payload,
+	}, &response)
+	return response.ProtectionBypass, err
+}
+
+const protectionBypassSecretAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// generateProtectionBypassSecret returns a random 32 character alphanumeric secret, the same format as
+// the secrets Vercel generates.
+func generateProtectionBypassSecret() (string, error) {
+	secret := make([]byte, 32)
+	size := big.NewInt(int64(len(protectionBypassSecretAlphabet)))
+	for i := range secret {
+		n, err := rand.Int(rand.Reader, size)
+		if err != nil {
+			return "", fmt.Errorf("unable to generate protection bypass secret: %w", err)
+		}
+		secret[i] = protectionBypassSecretAlphabet[n.Int64()]
+	}
+	return string(secret), nil
+}
+
+// CreateProtectionBypass adds a new Protection Bypass for Automation secret to a project, without affecting
+// any existing secrets. If no secret is specified, one is generated.
+func (c *Client) CreateProtectionBypass(ctx context.Context, request CreateProtectionBypassRequest) (s ProtectionBypassSecret, err error) {
+	// The response does not identify which secret Vercel generated, and other secrets may be created on
+	// the same project at the same time, so always generate the secret here.
+	if request.Secret == "" {
+		request.Secret, err = generateProtectionBypassSecret()
+		if err != nil {
+			return s, err
+		}
+	}
+
+	var note *string
+	if request.Note != "" {
+		note = &request.Note
+	}
+	payload := string(mustMarshal(struct {
+		Generate protectionBypassSecretRequest `json:"generate"`
+	}{
+		Generate: protectionBypassSecretRequest{
+			Secret: request.Secret,
+			Note:   note,
+		},
+	}))
+	bypasses, err := c.patchProtectionBypass(ctx, request.ProjectID, request.TeamID, payload)
+	if err != nil {
+		return s, fmt.Errorf("unable to create protection bypass: %w", err)
+	}
+
+	bypass, ok := bypasses[request.Secret]
+	if !ok || bypass.Scope != "automation-bypass" {
+		return s, fmt.Errorf("protection bypass was created successfully, but could not be found")
+	}
+	return ProtectionBypassSecret{
+		TeamID:    c.TeamID(request.TeamID),
+		ProjectID: request.ProjectID,
+		Secret:    request.Secret,
+		Note:      bypass.Note,
+	}, nil
+}
+
+// GetProtectionBypass returns a single Protection Bypass for Automation secret of a project.
+func (c *Client) GetProtectionBypass(ctx context.Context, projectID, teamID, secret string) (s ProtectionBypassSecret, err error) {
+	project, err := c.GetProject(ctx, projectID, teamID)
+	if err != nil {
+		return s, err
+	}
+	bypass, ok := project.ProtectionBypass[secret]
+	if !ok || bypass.Scope != "automation-bypass" {
+		return s, APIError{
+			StatusCode: 404,
+			Message:    "Protection Bypass not found",
+			Code:       "not_found",
+		}
+	}
+	return ProtectionBypassSecret{
+		TeamID:    c.TeamID(teamID),
+		ProjectID: projectID,
+		Secret:    secret,
+		Note:      bypass.Note,
+	}, nil
+}
+
+// UpdateProtectionBypass updates the note of a Protection Bypass for Automation secret.
+func (c *Client) UpdateProtectionBypass(ctx context.Context, request UpdateProtectionBypassRequest) (s ProtectionBypassSecret, err error) {
+	payload := string(mustMarshal(struct {
+		Update protectionBypassSecretRequest `json:"update"`
+	}{
+		Update: protectionBypassSecretRequest{
+			Secret: request.Secret,
+			Note:   &request.Note,
+		},
+	}))
+	_, err = c.patchProtectionBypass(ctx, request.ProjectID, request.TeamID, payload)
+	if err != nil {
+		return s, fmt.Errorf("unable to update protection bypass: %w", err)
+	}
+	return c.GetProtectionBypass(ctx, request.ProjectID, request.TeamID, request.Secret)
+}
+
+// DeleteProtectionBypass revokes a single Protection Bypass for Automation secret, without affecting any
+// other secrets.
+func (c *Client) DeleteProtectionBypass(ctx context.Context, request DeleteProtectionBypassRequest) error {
+	payload := getUpdateBypassProtectionRequestBody(false, request.Secret)
+	_, err := c.patchProtectionBypass(ctx, request.ProjectID, request.TeamID, payload)
+	if err != nil {
+		return fmt.Errorf("unable to delete protection bypass: %w", err)
+	}
+	return nil
+}
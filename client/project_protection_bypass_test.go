@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+)
+
+func TestCreateProtectionBypassGeneratesSecret(t *testing.T) {
+	var sent []string
+	h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Generate struct {
+				Secret string `json:"secret"`
+			} `json:"generate"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		sent = append(sent, body.Generate.Secret)
+		// Respond with every secret created so far, as Vercel does.
+		bypasses := map[string]ProtectionBypass{}
+		for _, s := range sent {
+			bypasses[s] = ProtectionBypass{Scope: "automation-bypass"}
+		}
+		fmt.Fprintln(w, string(mustMarshal(map[string]any{"protectionBypass": bypasses})))
+	}))
+	defer h.Close()
+	cl := New("SECRET_TOKEN")
+	cl.baseURL = h.URL
+
+	seen := map[string]bool{}
+	for i := 0; i < 2; i++ {
+		s, err := cl.CreateProtectionBypass(context.Background(), CreateProtectionBypassRequest{ProjectID: "prj_123"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !regexp.MustCompile(`^[a-zA-Z0-9]{32}$`).MatchString(s.Secret) {
+			t.Errorf("expected a 32 character alphanumeric secret, but got %q", s.Secret)
+		}
+		if s.Secret != sent[i] {
+			t.Errorf("expected the secret that was sent, %q, but got %q", sent[i], s.Secret)
+		}
+		if seen[s.Secret] {
+			t.Errorf("expected a new secret, but got %q again", s.Secret)
+		}
+		seen[s.Secret] = true
+	}
+}
@@ -135,3 +135,138 @@ func (c *Client) DeleteRollingRelease(ctx context.Context, projectID, teamID str
 	}, nil)
 	return err
 }
+
+// RollingReleaseDeployment is a deployment taking part in an active rolling release.
+type RollingReleaseDeployment struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	URL       string `json:"url"`
+	Target    string `json:"target"`
+	CreatedAt int64  `json:"createdAt"`
+}
+
+// ActiveRollingReleaseStage is a stage of an active rolling release.
+type ActiveRollingReleaseStage struct {
+	Index            int  `json:"index"`
+	IsFinalStage     bool `json:"isFinalStage"`
+	TargetPercentage int  `json:"targetPercentage"`
+	RequireApproval  bool `json:"requireApproval"`
+	Duration         *int `json:"duration"`
+}
+
+// ActiveRollingRelease is the state of a rollout between the current production deployment and a canary deployment.
+type ActiveRollingRelease struct {
+	State              string                      `json:"state"`
+	CurrentDeployment  *RollingReleaseDeployment   `json:"currentDeployment"`
+	CanaryDeployment   *RollingReleaseDeployment   `json:"canaryDeployment"`
+	QueuedDeploymentID *string                     `json:"queuedDeploymentId"`
+	AdvancementType    string                      `json:"advancementType"`
+	Stages             []ActiveRollingReleaseStage `json:"stages"`
+	ActiveStage        *ActiveRollingReleaseStage  `json:"activeStage"`
+	NextStage          *ActiveRollingReleaseStage  `json:"nextStage"`
+	StartedAt          int64                       `json:"startedAt"`
+	UpdatedAt          int64                       `json:"updatedAt"`
+}
+
+// GetActiveRollingRelease returns the rolling release currently in progress for a project. If there is no
+// rolling release in progress, nil is returned.
+func (c *Client) GetActiveRollingRelease(ctx context.Context, projectID, teamID string) (*ActiveRollingRelease, error) {
+	url := fmt.Sprintf("%s/v1/projects/%s/rolling-release?teamId=%s", c.baseURL, projectID, c.TeamID(teamID))
+	tflog.Info(ctx, "getting active rolling release", map[string]any{
+		"url": url,
+	})
+
+	var d struct {
+		RollingRelease *ActiveRollingRelease `json:"rollingRelease"`
+	}
+	err := c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+	}, &d)
+	if err != nil {
+		return nil, fmt.Errorf("error getting active rolling release: %w", err)
+	}
+	return d.RollingRelease, nil
+}
+
+// ApproveRollingReleaseStageRequest defines the information needed to advance an active rolling release.
+type ApproveRollingReleaseStageRequest struct {
+	ProjectID          string `json:"-"`
+	TeamID             string `json:"-"`
+	NextStageIndex     int    `json:"nextStageIndex"`
+	CanaryDeploymentID string `json:"canaryDeploymentId"`
+}
+
+// ApproveRollingReleaseStage advances an active rolling release to the given stage.
+func (c *Client) ApproveRollingReleaseStage(ctx context.Context, request ApproveRollingReleaseStageRequest) (*ActiveRollingRelease, error) {
+	url := fmt.Sprintf("%s/v1/projects/%s/rolling-release/approve-stage?teamId=%s", c.baseURL, request.ProjectID, c.TeamID(request.TeamID))
+	payload := string(mustMarshal(request))
+	tflog.Info(ctx, "approving rolling release stage", map[string]any{
+		"url":     url,
+		"payload": payload,
+	})
+
+	var d struct {
+		RollingRelease *ActiveRollingRelease `json:"rollingRelease"`
+	}
+	err := c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "POST",
+		url:    url,
+		body:   payload,
+	}, &d)
+	if err != nil {
+		return nil, fmt.Errorf("error approving rolling release stage: %w", err)
+	}
+	return d.RollingRelease, nil
+}
+
+// CompleteRollingReleaseRequest defines the information needed to complete an active rolling release.
+type CompleteRollingReleaseRequest struct {
+	ProjectID          string `json:"-"`
+	TeamID             string `json:"-"`
+	CanaryDeploymentID string `json:"canaryDeploymentId"`
+}
+
+// CompleteRollingRelease immediately sends all traffic to the canary deployment of an active rolling release.
+func (c *Client) CompleteRollingRelease(ctx context.Context, request CompleteRollingReleaseRequest) (*ActiveRollingRelease, error) {
+	url := fmt.Sprintf("%s/v1/projects/%s/rolling-release/complete?teamId=%s", c.baseURL, request.ProjectID, c.TeamID(request.TeamID))
+	payload := string(mustMarshal(request))
+	tflog.Info(ctx, "completing rolling release", map[string]any{
+		"url":     url,
+		"payload": payload,
+	})
+
+	var d struct {
+		RollingRelease *ActiveRollingRelease `json:"rollingRelease"`
+	}
+	err := c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "POST",
+		url:    url,
+		body:   payload,
+	}, &d)
+	if err != nil {
+		return nil, fmt.Errorf("error completing rolling release: %w", err)
+	}
+	return d.RollingRelease, nil
+}
+
+// RollbackProject points all production domains of a project back to the given deployment. This is
+// also how an active rolling release is aborted, by rolling back to its current deployment.
+func (c *Client) RollbackProject(ctx context.Context, projectID, teamID, deploymentID string) error {
+	url := fmt.Sprintf("%s/v9/projects/%s/rollback/%s", c.baseURL, projectID, deploymentID)
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
+	}
+	tflog.Info(ctx, "rolling back project", map[string]any{
+		"url": url,
+	})
+	return c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "POST",
+		url:    url,
+		body:   "",
+	}, nil)
+}
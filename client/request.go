@@ -104,7 +104,13 @@ func (c *Client) doRequest(req clientRequest, v any) error {
 
 func (c *Client) _doRequest(req *http.Request, v any, errorOnNoContent bool) error {
 	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.token))
-	resp, err := c.http().Do(req)
+	return doHTTPRequest(c.http(), req, v, errorOnNoContent)
+}
+
+// doHTTPRequest sends a request with the given http client, without adding any authorization, and converts
+// the response into either an APIError or the unmarshaled response body.
+func doHTTPRequest(client *http.Client, req *http.Request, v any, errorOnNoContent bool) error {
+	resp, err := client.Do(req)
 	if err != nil {
 		return fmt.Errorf("error doing http request: %w", err)
 	}
@@ -166,3 +172,9 @@ func (c *Client) _doRequest(req *http.Request, v any, errorOnNoContent bool) err
 
 	return nil
 }
+
+// pagination is the pagination information returned by Vercel's list endpoints. Next is the
+// value to pass as `until` to retrieve the next page of results, and is null on the last page.
+type pagination struct {
+	Next *int64 `json:"next"`
+}
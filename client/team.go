@@ -98,6 +98,11 @@ type SpacesConfig struct {
 	Enabled bool `json:"enabled"`
 }
 
+// TeamBilling describes the plan a team is subscribed to, e.g. "hobby", "pro" or "enterprise".
+type TeamBilling struct {
+	Plan string `json:"plan"`
+}
+
 // Team is the information returned by the vercel api when a team is created.
 type Team struct {
 	ID                                 string         `json:"id"`
@@ -116,6 +121,7 @@ type Team struct {
 	Spaces                             *SpacesConfig  `json:"spaces"`
 	HideIPAddresses                    *bool          `json:"hideIpAddresses"`
 	HideIPAddressesInLogDrains         *bool          `json:"hideIpAddressesInLogDrains,omitempty"`
+	Billing                            *TeamBilling   `json:"billing,omitempty"`
 }
 
 // GetTeam returns information about an existing team within vercel.
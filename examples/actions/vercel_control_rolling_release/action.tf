@@ -0,0 +1,22 @@
+data "vercel_project_rolling_release_status" "example" {
+  project_id = vercel_project.example.id
+}
+
+# Advance the rolling release to its next stage, e.g. from a release pipeline once checks have passed:
+# terraform apply -invoke action.vercel_control_rolling_release.approve
+action "vercel_control_rolling_release" "approve" {
+  config {
+    project_id           = vercel_project.example.id
+    operation            = "approve"
+    canary_deployment_id = data.vercel_project_rolling_release_status.example.canary_deployment_id
+  }
+}
+
+# Roll production back to the deployment traffic is being shifted from.
+action "vercel_control_rolling_release" "abort" {
+  config {
+    project_id           = vercel_project.example.id
+    operation            = "abort"
+    canary_deployment_id = data.vercel_project_rolling_release_status.example.canary_deployment_id
+  }
+}
@@ -0,0 +1,23 @@
+resource "vercel_project_deploy_hook" "example" {
+  project_id = vercel_project.example.id
+  name       = "rebuild main"
+  ref        = "main"
+}
+
+action "vercel_trigger_deploy_hook" "example" {
+  config {
+    url = vercel_project_deploy_hook.example.url
+  }
+}
+
+# Trigger a new deployment whenever the Edge Config changes.
+resource "terraform_data" "edge_config" {
+  input = vercel_edge_config_item.example.value
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.vercel_trigger_deploy_hook.example]
+    }
+  }
+}
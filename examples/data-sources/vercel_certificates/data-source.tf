@@ -0,0 +1,15 @@
+data "vercel_certificates" "example" {
+  domain = "example.com"
+}
+
+# Alert when a certificate that should renew automatically is close to expiry,
+# as this means it has failed to renew.
+check "certificates_renewed" {
+  assert {
+    condition = alltrue([
+      for cert in data.vercel_certificates.example.certificates :
+      !cert.auto_renew || cert.days_until_expiry > 14
+    ])
+    error_message = "A certificate for example.com has failed to renew."
+  }
+}
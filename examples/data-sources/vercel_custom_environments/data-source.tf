@@ -0,0 +1,13 @@
+data "vercel_project" "example" {
+  name = "example-project-with-custom-environments"
+}
+
+data "vercel_custom_environments" "example" {
+  project_id = data.vercel_project.example.id
+}
+
+output "environment_domains" {
+  value = {
+    for env in data.vercel_custom_environments.example.environments : env.name => env.domains
+  }
+}
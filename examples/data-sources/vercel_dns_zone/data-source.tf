@@ -0,0 +1,9 @@
+data "vercel_dns_zone" "example" {
+  domain = "example.com"
+}
+
+# Save the records as a zone file, for example to move them to another DNS provider.
+resource "local_file" "zone_file" {
+  filename = "${path.module}/example.com.zone"
+  content  = data.vercel_dns_zone.example.zone_file
+}
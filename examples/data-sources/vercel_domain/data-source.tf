@@ -0,0 +1,7 @@
+data "vercel_domain" "example" {
+  name = "example.com"
+}
+
+output "uses_vercel_dns" {
+  value = data.vercel_domain.example.uses_vercel_dns
+}
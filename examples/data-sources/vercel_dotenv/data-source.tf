@@ -0,0 +1,15 @@
+data "vercel_dotenv" "production" {
+  filename           = "${path.module}/.env.production"
+  target             = ["production"]
+  sensitive_keys     = ["*_SECRET", "*_TOKEN"]
+  non_sensitive_keys = ["NEXT_PUBLIC_*"]
+}
+
+resource "vercel_project" "example" {
+  name = "example-project"
+}
+
+resource "vercel_project_environment_variables" "example" {
+  project_id = vercel_project.example.id
+  variables  = data.vercel_dotenv.production.variables
+}
@@ -0,0 +1,19 @@
+# In this example, a monorepo contains a Next.js app in `apps/web`,
+# which depends on a shared `packages/ui` package.
+data "vercel_project_affected" "web" {
+  root_directory = "apps/web"
+  dependencies   = ["packages/ui", "pnpm-lock.yaml"]
+  base_ref       = "origin/main"
+}
+
+data "vercel_project_directory" "web" {
+  path = "../apps/web"
+}
+
+resource "vercel_deployment" "web" {
+  count = data.vercel_project_affected.web.affected ? 1 : 0
+
+  project_id  = "prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  files       = data.vercel_project_directory.web.files
+  path_prefix = data.vercel_project_directory.web.path
+}
@@ -0,0 +1,11 @@
+data "vercel_project" "example" {
+  name = "example-project-with-rolling-release"
+}
+
+data "vercel_project_rolling_release_status" "example" {
+  project_id = data.vercel_project.example.id
+}
+
+output "rollout_percentage" {
+  value = data.vercel_project_rolling_release_status.example.current_percentage
+}
@@ -0,0 +1,11 @@
+# Create a token that only exists for the duration of the Terraform run.
+ephemeral "vercel_edge_config_token" "temporary" {
+  edge_config_id = "ecfg_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  label          = "terraform"
+}
+
+# Read an existing token.
+ephemeral "vercel_edge_config_token" "existing" {
+  edge_config_id = "ecfg_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  token          = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
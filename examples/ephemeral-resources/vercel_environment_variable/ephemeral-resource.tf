@@ -0,0 +1,4 @@
+ephemeral "vercel_environment_variable" "example" {
+  project_id = "prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  id         = "xxxxxxxxxxxxxxxx"
+}
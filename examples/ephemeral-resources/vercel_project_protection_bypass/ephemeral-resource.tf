@@ -0,0 +1,9 @@
+ephemeral "vercel_project_protection_bypass" "example" {
+  project_id = "prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+}
+
+# If the project has more than one secret, select one by its note.
+ephemeral "vercel_project_protection_bypass" "ci" {
+  project_id = "prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  note       = "ci"
+}
@@ -0,0 +1,11 @@
+# If importing into a personal account, or with a team configured on
+# the provider, simply use the certificate id.
+# - certificate_id can be queried from the Vercel API directly (https://vercel.com/docs/rest-api/endpoints/certs).
+# The private key and certificates are not returned by the Vercel API, so they are
+# taken from the configuration on the next apply, without uploading the certificate again.
+terraform import vercel_custom_certificate.example cert_xxxxxxxxxxxxxxxxxxxxxxxx
+
+# Alternatively, you can import via the team_id and certificate_id.
+# - team_id can be found in the team `settings` tab in the Vercel UI.
+# - certificate_id can be queried from the Vercel API directly (https://vercel.com/docs/rest-api/endpoints/certs).
+terraform import vercel_custom_certificate.example team_xxxxxxxxxxxxxxxxxxxxxxxx/cert_xxxxxxxxxxxxxxxxxxxxxxxx
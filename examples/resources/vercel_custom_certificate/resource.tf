@@ -2,4 +2,12 @@ resource "vercel_custom_certificate" "example" {
   private_key                       = file("private.key")
   certificate                       = file("certificate.crt")
   certificate_authority_certificate = file("ca.crt")
+
+  # Warn when planning if the certificate expires within two weeks.
+  expiry_warning_days = 14
+
+  # Upload a rotated certificate before the old one is removed.
+  lifecycle {
+    create_before_destroy = true
+  }
 }
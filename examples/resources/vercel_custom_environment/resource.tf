@@ -11,3 +11,9 @@ resource "vercel_custom_environment" "example" {
     type    = "startsWith"
   }
 }
+
+# The branches of recent deployments that match the branch tracking pattern
+# are shown at plan time, whenever the pattern changes.
+output "tracked_branches" {
+  value = vercel_custom_environment.example.branch_tracking_matches
+}
@@ -28,6 +28,14 @@ resource "vercel_deployment" "files_example" {
   environment = {
     FOO = "bar"
   }
+
+  # Override the resources used by specific Vercel Functions.
+  functions = {
+    "api/*.js" = {
+      max_duration = 60
+      regions      = ["iad1"]
+    }
+  }
 }
 
 ## Or deploying a specific commit or branch
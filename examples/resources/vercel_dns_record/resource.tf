@@ -67,3 +67,27 @@ resource "vercel_dns_record" "txt" {
   ttl    = 60
   value  = "some text value"
 }
+
+resource "vercel_dns_record" "caa_block" {
+  domain = "example.com"
+  name   = ""
+  type   = "CAA"
+  ttl    = 60
+  caa = {
+    flags = 0
+    tag   = "issue"
+    value = "letsencrypt.org"
+  }
+}
+
+resource "vercel_dns_record" "https" {
+  domain = "example.com"
+  name   = "subdomain"
+  type   = "HTTPS"
+  ttl    = 60
+  https = {
+    priority = 1
+    target   = "."
+    params   = "alpn=h2,h3"
+  }
+}
@@ -0,0 +1,36 @@
+# Records configured individually.
+resource "vercel_dns_zone" "example" {
+  domain = "example.com"
+  records = [
+    {
+      name  = "www"
+      type  = "CNAME"
+      value = "cname.vercel-dns.com"
+    },
+    {
+      name        = ""
+      type        = "MX"
+      value       = "mail.example.com"
+      mx_priority = 10
+    },
+    {
+      name = "_sip._tcp"
+      type = "SRV"
+      ttl  = 3600
+      srv = {
+        priority = 10
+        weight   = 5
+        port     = 5060
+        target   = "sip.example.com"
+      }
+    },
+  ]
+}
+
+# Records from a zone file. With exclusive set, any other records
+# for the domain are deleted.
+resource "vercel_dns_zone" "example_zone_file" {
+  domain    = "example.org"
+  zone_file = file("${path.module}/example.org.zone")
+  exclusive = true
+}
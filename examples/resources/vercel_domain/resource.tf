@@ -0,0 +1,17 @@
+resource "vercel_domain" "example" {
+  name = "example.com"
+
+  # Fail the apply if the domain has not been verified within 30 minutes.
+  wait_for_verification = true
+  verification_timeout  = "30m"
+}
+
+# For a domain whose DNS is not served by Vercel, prove ownership by adding
+# the verification TXT record with the DNS provider.
+output "verification_records" {
+  value = vercel_domain.example.verification_records
+}
+
+output "intended_nameservers" {
+  value = vercel_domain.example.intended_nameservers
+}
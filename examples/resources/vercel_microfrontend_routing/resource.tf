@@ -0,0 +1,42 @@
+data "vercel_project" "parent_mfe_project" {
+  name = "my parent project"
+}
+
+data "vercel_project" "child_mfe_project" {
+  name = "my child project"
+}
+
+resource "vercel_microfrontend_group" "example_mfe_group" {
+  name = "my mfe"
+  default_app = {
+    project_id = data.vercel_project.parent_mfe_project.id
+  }
+}
+
+resource "vercel_microfrontend_group_membership" "child_mfe_project_mfe_membership" {
+  project_id             = data.vercel_project.child_mfe_project.id
+  microfrontend_group_id = vercel_microfrontend_group.example_mfe_group.id
+}
+
+resource "vercel_microfrontend_routing" "example" {
+  microfrontend_group_id = vercel_microfrontend_group.example_mfe_group.id
+  default_app = {
+    project_id = data.vercel_project.parent_mfe_project.id
+    fallback   = "my-parent-project.vercel.app"
+  }
+  applications = [
+    {
+      project_id = data.vercel_project.child_mfe_project.id
+      paths      = ["/docs", "/docs/:path*"]
+    }
+  ]
+
+  depends_on = [vercel_microfrontend_group_membership.child_mfe_project_mfe_membership]
+}
+
+# Write the generated microfrontends.json into the parent project's source,
+# so that it is included in its next deployment.
+resource "local_file" "microfrontends_json" {
+  filename = "${path.module}/parent/microfrontends.json"
+  content  = vercel_microfrontend_routing.example.json
+}
@@ -0,0 +1,8 @@
+# If importing with a team configured on the provider, use the project ID and the path of the Cron Job.
+# - project_id can be found in the project `settings` tab in the Vercel UI.
+terraform import vercel_project_cron_job.example prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx:/api/cleanup
+
+# Alternatively, you can import via the team_id, project_id and the path of the Cron Job.
+# - team_id can be found in the team `settings` tab in the Vercel UI.
+# - project_id can be found in the project `settings` tab in the Vercel UI.
+terraform import vercel_project_cron_job.example team_xxxxxxxxxxxxxxxxxxxxxxxx/prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx:/api/cleanup
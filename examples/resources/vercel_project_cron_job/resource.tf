@@ -0,0 +1,23 @@
+resource "vercel_project" "example" {
+  name      = "example-project"
+  framework = "nextjs"
+
+  git_repository = {
+    type = "github"
+    repo = "vercel/some-repo"
+  }
+}
+
+# The Cron Job must also be defined in the `crons` field of vercel.json:
+# {
+#   "crons": [{ "path": "/api/cleanup", "schedule": "0 5 * * *" }]
+# }
+resource "vercel_project_cron_job" "cleanup" {
+  project_id = vercel_project.example.id
+  path       = "/api/cleanup"
+  schedule   = "0 5 * * *"
+}
+
+output "next_cleanup" {
+  value = vercel_project_cron_job.cleanup.next_runs[0]
+}
@@ -0,0 +1,9 @@
+# If importing into a personal account, or with a team configured on
+# the provider, simply use the project_id and the deploy hook id.
+# - project_id can be found in the project `settings` tab in the Vercel UI.
+# - the deploy hook id can be found in the `id` of the deploy hook in the project's `git_repository` settings.
+terraform import vercel_project_deploy_hook.example prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx/xxxxxxxxxxxxxxxxxxxx
+
+# Alternatively, you can import via the team_id, project_id and deploy hook id.
+# - team_id can be found in the team `settings` tab in the Vercel UI.
+terraform import vercel_project_deploy_hook.example team_xxxxxxxxxxxxxxxxxxxxxxxx/prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx/xxxxxxxxxxxxxxxxxxxx
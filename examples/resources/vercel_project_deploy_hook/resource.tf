@@ -0,0 +1,14 @@
+resource "vercel_project" "example" {
+  name = "example-project"
+
+  git_repository = {
+    type = "github"
+    repo = "vercel/some-repo"
+  }
+}
+
+resource "vercel_project_deploy_hook" "example" {
+  project_id = vercel_project.example.id
+  name       = "rebuild main"
+  ref        = "main"
+}
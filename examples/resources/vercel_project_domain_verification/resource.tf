@@ -0,0 +1,28 @@
+resource "vercel_project" "example" {
+  name = "example-project"
+}
+
+# A domain that is also in use by another Vercel account,
+# so it must be verified before it can be used.
+resource "vercel_project_domain" "example" {
+  project_id = vercel_project.example.id
+  domain     = "app.example.com"
+}
+
+# Create the verification TXT record with the domain's DNS provider.
+resource "cloudflare_dns_record" "verification" {
+  zone_id = var.cloudflare_zone_id
+  type    = vercel_project_domain.example.verification[0].type
+  name    = vercel_project_domain.example.verification[0].domain
+  content = vercel_project_domain.example.verification[0].value
+  ttl     = 60
+}
+
+# Wait for Vercel to see the record and verify the domain.
+resource "vercel_project_domain_verification" "example" {
+  project_id = vercel_project.example.id
+  domain     = vercel_project_domain.example.domain
+  timeout    = "15m"
+
+  depends_on = [cloudflare_dns_record.verification]
+}
@@ -0,0 +1,9 @@
+# If importing into a personal account, or with a team configured on
+# the provider, simply use the project_id and the secret.
+# - project_id can be found in the project `settings` tab in the Vercel UI.
+# - the secret can be found in the project's Deployment Protection settings in the Vercel UI.
+terraform import vercel_project_protection_bypass.example prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx/xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
+
+# Alternatively, you can import via the team_id, project_id and secret.
+# - team_id can be found in the team `settings` tab in the Vercel UI.
+terraform import vercel_project_protection_bypass.example team_xxxxxxxxxxxxxxxxxxxxxxxx/prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx/xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
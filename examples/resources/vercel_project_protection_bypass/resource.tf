@@ -0,0 +1,24 @@
+resource "vercel_project" "example" {
+  name = "example-project"
+}
+
+# Give each automation service its own secret.
+resource "vercel_project_protection_bypass" "playwright" {
+  project_id = vercel_project.example.id
+  note       = "playwright end-to-end tests"
+
+  # Change the rotation value to generate a new secret. The new secret is
+  # created before the old secret is revoked.
+  triggers = {
+    rotation = "2025-01"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "vercel_project_protection_bypass" "lighthouse" {
+  project_id = vercel_project.example.id
+  note       = "lighthouse audits"
+}
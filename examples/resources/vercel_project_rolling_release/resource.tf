@@ -12,6 +12,9 @@ resource "vercel_project_rolling_release" "example" {
 		},
 		{
 			target_percentage = 50
+		},
+		{
+			target_percentage = 100
 		}
 	]
 }
@@ -18,3 +18,15 @@ resource "vercel_shared_environment_variable" "example" {
     vercel_project.example.id
   ]
 }
+
+# A shared environment variable that is associated with
+# every Next.js project whose name starts with "web-".
+resource "vercel_shared_environment_variable" "selector_example" {
+  key    = "EXAMPLE_SELECTOR"
+  value  = "some_value"
+  target = ["production", "preview"]
+  project_selector = {
+    name      = "web-*"
+    framework = "nextjs"
+  }
+}
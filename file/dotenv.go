@@ -0,0 +1,141 @@
+package file
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DotenvVariable is a single variable parsed from a dotenv file.
+type DotenvVariable struct {
+	Key   string
+	Value string
+}
+
+var dotenvKeyRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ParseDotenv parses the contents of a dotenv file, returning the variables in the order they are
+// first defined. If a key is defined more than once, the last value wins.
+//
+// Values may be unquoted, or wrapped in single quotes, double quotes or backticks, and quoted
+// values may span multiple lines. Lines may be prefixed with `export`, and anything following a
+// `#` at the start of a line, or after whitespace in an unquoted value, is a comment. References
+// to earlier variables, such as `${VAR}` or `${VAR:-default}`, are expanded in unquoted and
+// double quoted values. Double quoted values also support the `\n`, `\r`, `\t`, `\"`, `\\` and
+// `\$` escape sequences.
+func ParseDotenv(content string) ([]DotenvVariable, error) {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	values := map[string]string{}
+	var keys []string
+	for i := 0; i < len(lines); i++ {
+		lineNo := i + 1
+		line := strings.TrimSpace(lines[i])
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if rest, ok := strings.CutPrefix(line, "export"); ok && (strings.HasPrefix(rest, " ") || strings.HasPrefix(rest, "\t")) {
+			line = strings.TrimSpace(rest)
+		}
+
+		key, rest, ok := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !ok || !dotenvKeyRe.MatchString(key) {
+			return nil, fmt.Errorf("line %d: expected KEY=VALUE, but got %q", lineNo, line)
+		}
+		rest = strings.TrimSpace(rest)
+
+		var value string
+		if rest != "" && strings.ContainsRune("\"'`", rune(rest[0])) {
+			quote := rest[0]
+			body := rest[1:]
+			for {
+				end := closingQuote(body, quote)
+				if end >= 0 {
+					trailing := strings.TrimSpace(body[end+1:])
+					if trailing != "" && !strings.HasPrefix(trailing, "#") {
+						return nil, fmt.Errorf("line %d: unexpected characters after the quoted value of %s", i+1, key)
+					}
+					body = body[:end]
+					break
+				}
+				i++
+				if i >= len(lines) {
+					return nil, fmt.Errorf("line %d: unterminated quoted value for %s", lineNo, key)
+				}
+				body += "\n" + lines[i]
+			}
+			value = body
+			if quote == '"' {
+				value = interpolate(body, true, values)
+			}
+		} else {
+			// A comment in an unquoted value must be preceded by whitespace, so that values
+			// such as URLs with fragments are left intact.
+			for j := 1; j < len(rest); j++ {
+				if rest[j] == '#' && (rest[j-1] == ' ' || rest[j-1] == '\t') {
+					rest = rest[:j]
+					break
+				}
+			}
+			value = interpolate(strings.TrimSpace(rest), false, values)
+		}
+
+		if _, ok := values[key]; !ok {
+			keys = append(keys, key)
+		}
+		values[key] = value
+	}
+
+	variables := make([]DotenvVariable, 0, len(keys))
+	for _, k := range keys {
+		variables = append(variables, DotenvVariable{Key: k, Value: values[k]})
+	}
+	return variables, nil
+}
+
+// closingQuote returns the index of the quote that closes a quoted value, or -1 if the value is
+// not closed. Within double quotes, a quote can be escaped with a backslash.
+func closingQuote(s string, quote byte) int {
+	for i := 0; i < len(s); i++ {
+		if quote == '"' && s[i] == '\\' {
+			i++
+			continue
+		}
+		if s[i] == quote {
+			return i
+		}
+	}
+	return -1
+}
+
+// interpolate expands references to other variables within a value. When escapes is true, the
+// escape sequences supported by double quoted values are also processed.
+func interpolate(s string, escapes bool, values map[string]string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '\\' && i+1 < len(s) {
+			next := s[i+1]
+			replacement, ok := map[byte]string{'n': "\n", 'r': "\r", 't': "\t", '"': "\"", '\\': "\\", '$': "$"}[next]
+			if ok && (escapes || next == '$') {
+				b.WriteString(replacement)
+				i++
+				continue
+			}
+		}
+		if c == '$' && i+1 < len(s) && s[i+1] == '{' {
+			if end := strings.IndexByte(s[i+2:], '}'); end >= 0 {
+				name, fallback, _ := strings.Cut(s[i+2:i+2+end], ":-")
+				value := values[name]
+				if value == "" {
+					value = fallback
+				}
+				b.WriteString(value)
+				i += end + 2
+				continue
+			}
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
@@ -0,0 +1,61 @@
+package file
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseDotenv(t *testing.T) {
+	content := "# a comment\n" +
+		"PLAIN=value\n" +
+		"export EXPORTED=exported\n" +
+		"SPACED = spaced value   \n" +
+		"INLINE=value # a comment\n" +
+		"URL=https://example.com/#fragment\n" +
+		"EMPTY=\n" +
+		"SINGLE='single ${PLAIN} \\n'\n" +
+		"DOUBLE=\"double ${PLAIN}\\n\\\"quoted\\\"\" # a comment\n" +
+		"BACKTICK=`it's \"quoted\"`\n" +
+		"MULTILINE=\"line one\n" +
+		"line two\"\n" +
+		"EXPANDED=${PLAIN}-${MISSING}-${MISSING:-default}\n" +
+		"ESCAPED=\\${PLAIN}\n" +
+		"PLAIN=overridden\r\n"
+
+	got, err := ParseDotenv(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := []DotenvVariable{
+		{Key: "PLAIN", Value: "overridden"},
+		{Key: "EXPORTED", Value: "exported"},
+		{Key: "SPACED", Value: "spaced value"},
+		{Key: "INLINE", Value: "value"},
+		{Key: "URL", Value: "https://example.com/#fragment"},
+		{Key: "EMPTY", Value: ""},
+		{Key: "SINGLE", Value: "single ${PLAIN} \\n"},
+		{Key: "DOUBLE", Value: "double value\n\"quoted\""},
+		{Key: "BACKTICK", Value: "it's \"quoted\""},
+		{Key: "MULTILINE", Value: "line one\nline two"},
+		{Key: "EXPANDED", Value: "value--default"},
+		{Key: "ESCAPED", Value: "${PLAIN}"},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("unexpected variables\ngot:  %q\nwant: %q", got, want)
+	}
+}
+
+func TestParseDotenvErrors(t *testing.T) {
+	for name, content := range map[string]string{
+		"missing equals":      "KEY",
+		"invalid key":         "1KEY=value",
+		"unterminated quote":  "KEY=\"value\nOTHER=value",
+		"trailing characters": "KEY=\"value\" trailing",
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseDotenv(content); err == nil {
+				t.Errorf("expected an error parsing %q", content)
+			}
+		})
+	}
+}
@@ -3,39 +3,119 @@ package file
 import (
 	"fmt"
 	"io/fs"
+	"os"
 	"path/filepath"
-
-	gitignore "github.com/sabhiram/go-gitignore"
 )
 
-// GetPaths is used to find all the files within a directory that do not match a specified
-// set of ignore patterns.
-func GetPaths(basePath string, ignorePatterns []string) ([]string, error) {
-	ignore := gitignore.CompileIgnoreLines(ignorePatterns...)
+// PathOptions controls which files GetPaths returns, in addition to any ignore files.
+type PathOptions struct {
+	// Include limits the result to files matching at least one of the patterns. The patterns
+	// use .vercelignore syntax and are relative to the base path. If empty, all files are included.
+	Include []string
+	// Exclude removes any files matching one of the patterns. The patterns use .vercelignore
+	// syntax and are applied after the ignore files.
+	Exclude []string
+	// FollowSymlinks causes symlinks to be resolved, so a symlinked directory is walked and a
+	// symlinked file is treated as the file it points to. Otherwise symlinks are returned as-is.
+	FollowSymlinks bool
+}
+
+// Warnings describes any of the Include or Exclude patterns that could not be compiled, and so
+// are not used.
+func (o PathOptions) Warnings() []string {
+	_, include := compilePatterns(o.Include)
+	_, exclude := compilePatterns(o.Exclude)
+	return append(include, exclude...)
+}
 
-	var paths []string
-	err := filepath.WalkDir(
-		basePath,
-		func(path string, d fs.DirEntry, err error) error {
+// GetPaths is used to find all the files within a directory that are not ignored.
+func GetPaths(basePath string, ignores *Ignores, opts PathOptions) ([]string, error) {
+	exclude, _ := compilePatterns(opts.Exclude)
+	w := walker{
+		ignores:        ignores,
+		include:        NewMatcher(opts.Include),
+		exclude:        exclude,
+		followSymlinks: opts.FollowSymlinks,
+		active:         map[string]bool{},
+	}
+	err := w.walkDir(basePath, "")
+	if err != nil {
+		return nil, fmt.Errorf("error finding paths: %w", err)
+	}
+
+	return w.paths, nil
+}
+
+type walker struct {
+	ignores        *Ignores
+	include        Matcher
+	exclude        []pattern
+	followSymlinks bool
+	// active holds the resolved paths of the directories currently being walked, so that a
+	// symlink pointing at one of its parents can be detected.
+	active map[string]bool
+	paths  []string
+}
+
+func (w *walker) walkDir(dir, rel string) error {
+	realPath, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return err
+	}
+	if w.active[realPath] {
+		return fmt.Errorf("symlink loop detected at %s", dir)
+	}
+	w.active[realPath] = true
+	defer delete(w.active, realPath)
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		entryRel := entry.Name()
+		if rel != "" {
+			entryRel = rel + "/" + entry.Name()
+		}
+
+		isDir := entry.IsDir()
+		if w.followSymlinks && entry.Type()&fs.ModeSymlink != 0 {
+			info, err := os.Stat(path)
 			if err != nil {
-				return err
+				return fmt.Errorf("unable to resolve symlink %s: %w", path, err)
 			}
-			ignored := ignore.MatchesPath(path)
+			isDir = info.IsDir()
+		}
 
-			if d.IsDir() && ignored {
-				return filepath.SkipDir
-			}
-			if ignored || d.IsDir() {
-				return nil
+		if w.ignored(entryRel, isDir) {
+			continue
+		}
+		if isDir {
+			if err := w.walkDir(path, entryRel); err != nil {
+				return err
 			}
+			continue
+		}
+		if !w.included(entryRel) {
+			continue
+		}
 
-			paths = append(paths, path)
-			return nil
-		},
-	)
-	if err != nil {
-		return nil, fmt.Errorf("error finding paths: %w", err)
+		w.paths = append(w.paths, path)
 	}
+	return nil
+}
+
+func (w *walker) ignored(rel string, isDir bool) bool {
+	ignored := w.ignores.ignored(rel, isDir)
+	for _, p := range w.exclude {
+		if p.matches(rel, isDir) {
+			ignored = !p.negate
+		}
+	}
+	return ignored
+}
 
-	return paths, nil
+func (w *walker) included(rel string) bool {
+	return w.include.Empty() || w.include.Matches(rel)
 }
@@ -0,0 +1,141 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestGetPathsOptions(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{
+		"apps/web/index.js",
+		"apps/web/index.js.map",
+		"apps/docs/index.js",
+		"packages/ui/button.js",
+		"package.json",
+	} {
+		writeFile(t, filepath.Join(dir, name))
+	}
+
+	for _, tc := range []struct {
+		Name string
+		Opts PathOptions
+		Want []string
+	}{
+		{
+			Name: "no options",
+			Want: []string{"apps/docs/index.js", "apps/web/index.js", "apps/web/index.js.map", "package.json", "packages/ui/button.js"},
+		},
+		{
+			Name: "include directory",
+			Opts: PathOptions{Include: []string{"apps/web", "package.json"}},
+			Want: []string{"apps/web/index.js", "apps/web/index.js.map", "package.json"},
+		},
+		{
+			Name: "include glob",
+			Opts: PathOptions{Include: []string{"apps/*/index.js"}},
+			Want: []string{"apps/docs/index.js", "apps/web/index.js"},
+		},
+		{
+			Name: "exclude",
+			Opts: PathOptions{Exclude: []string{"*.map", "packages/"}},
+			Want: []string{"apps/docs/index.js", "apps/web/index.js", "package.json"},
+		},
+		{
+			Name: "include and exclude",
+			Opts: PathOptions{Include: []string{"apps/**"}, Exclude: []string{"*.map"}},
+			Want: []string{"apps/docs/index.js", "apps/web/index.js"},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			got := getRelativePaths(t, dir, tc.Opts)
+			if !slices.Equal(got, tc.Want) {
+				t.Errorf("unexpected paths\ngot:  %v\nwant: %v", got, tc.Want)
+			}
+		})
+	}
+}
+
+func TestPathOptionsWarnings(t *testing.T) {
+	opts := PathOptions{
+		Include: []string{"apps/**", "[z-a]"},
+		Exclude: []string{"*.map", "[[:nope:]]"},
+	}
+	warnings := opts.Warnings()
+	if len(warnings) != 2 || !strings.Contains(warnings[0], `"[z-a]"`) || !strings.Contains(warnings[1], `"[[:nope:]]"`) {
+		t.Errorf("unexpected warnings: %q", warnings)
+	}
+}
+
+func TestGetPathsSymlinks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlinks require elevated permissions on windows")
+	}
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "shared", "a.js"))
+	if err := os.Symlink("shared", filepath.Join(dir, "linked")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("shared/a.js", filepath.Join(dir, "b.js")); err != nil {
+		t.Fatal(err)
+	}
+
+	got := getRelativePaths(t, dir, PathOptions{})
+	want := []string{"b.js", "linked", "shared/a.js"}
+	if !slices.Equal(got, want) {
+		t.Errorf("unexpected paths without following symlinks\ngot:  %v\nwant: %v", got, want)
+	}
+
+	got = getRelativePaths(t, dir, PathOptions{FollowSymlinks: true})
+	want = []string{"b.js", "linked/a.js", "shared/a.js"}
+	if !slices.Equal(got, want) {
+		t.Errorf("unexpected paths following symlinks\ngot:  %v\nwant: %v", got, want)
+	}
+
+	if err := os.Symlink("..", filepath.Join(dir, "shared", "loop")); err != nil {
+		t.Fatal(err)
+	}
+	ignores, err := GetIgnores(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := GetPaths(dir, ignores, PathOptions{FollowSymlinks: true}); err == nil {
+		t.Error("expected an error for a symlink loop")
+	}
+}
+
+func writeFile(t *testing.T, path string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func getRelativePaths(t *testing.T, dir string, opts PathOptions) []string {
+	t.Helper()
+	ignores, err := GetIgnores(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	paths, err := GetPaths(dir, ignores, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rel []string
+	for _, p := range paths {
+		r, err := filepath.Rel(dir, p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rel = append(rel, filepath.ToSlash(r))
+	}
+	slices.Sort(rel)
+	return rel
+}
@@ -0,0 +1,196 @@
+package file
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// FileHash is the size and SHA1 of the content of a file, as expected by the Vercel file upload API.
+type FileHash struct {
+	Size int64  `json:"size"`
+	Sha  string `json:"sha"`
+	// Mode is the mode of the uploaded file. For a symlink that is not followed this is the mode
+	// of the symlink itself, and the content is the path the symlink points to.
+	Mode fs.FileMode `json:"mode"`
+	// Symlink is true if the path is a symlink, regardless of whether it was followed.
+	Symlink bool `json:"symlink"`
+}
+
+// HashOptions controls how HashFiles reads files.
+type HashOptions struct {
+	// FollowSymlinks causes symlinks to be hashed using the content of the file they point to,
+	// rather than the path they point to.
+	FollowSymlinks bool
+	// CacheFile is an optional path used to store hashes between runs. A file with the same
+	// size, modification time and mode as the cached entry is not read again.
+	CacheFile string
+}
+
+// hashCacheEntry is a single entry in the hash cache, keyed by path.
+type hashCacheEntry struct {
+	Size    int64       `json:"size"`
+	ModTime int64       `json:"mod_time"`
+	Mode    fs.FileMode `json:"mode"`
+	Hash    FileHash    `json:"hash"`
+}
+
+// racyInterval is how recently a file can have been modified and still be cached. A file
+// modified within this window could change again without its modification time changing,
+// so it is always re-hashed.
+const racyInterval = 2 * time.Second
+
+// HashFiles hashes a set of files in parallel, streaming the content of each file rather than
+// reading it into memory.
+func HashFiles(paths []string, opts HashOptions) (map[string]FileHash, error) {
+	cache := readHashCache(opts.CacheFile)
+	entries := make([]hashCacheEntry, len(paths))
+	cacheable := make([]bool, len(paths))
+	errs := make([]error, len(paths))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(runtime.NumCPU(), len(paths)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				entries[i], cacheable[i], errs[i] = hashFile(paths[i], opts.FollowSymlinks, cache)
+			}
+		}()
+	}
+	for i := range paths {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	hashes := make(map[string]FileHash, len(paths))
+	updated := map[string]hashCacheEntry{}
+	for i, path := range paths {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("could not hash file %s: %w", path, errs[i])
+		}
+		hashes[path] = entries[i].Hash
+		if cacheable[i] {
+			updated[path] = entries[i]
+		}
+	}
+
+	if opts.CacheFile != "" {
+		if err := writeHashCache(opts.CacheFile, updated); err != nil {
+			return nil, err
+		}
+	}
+	return hashes, nil
+}
+
+// hashFile hashes a single file, using the cache if the file has not changed. It also reports
+// whether the result can be stored in the cache.
+func hashFile(path string, followSymlinks bool, cache map[string]hashCacheEntry) (hashCacheEntry, bool, error) {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return hashCacheEntry{}, false, err
+	}
+	isSymlink := info.Mode()&fs.ModeSymlink != 0
+
+	if isSymlink && !followSymlinks {
+		target, err := os.Readlink(path)
+		if err != nil {
+			return hashCacheEntry{}, false, err
+		}
+		rawSha := sha1.Sum([]byte(target))
+		return hashCacheEntry{
+			Hash: FileHash{
+				Size:    int64(len(target)),
+				Sha:     hex.EncodeToString(rawSha[:]),
+				Mode:    info.Mode(),
+				Symlink: true,
+			},
+		}, false, nil
+	}
+
+	if isSymlink {
+		info, err = os.Stat(path)
+		if err != nil {
+			return hashCacheEntry{}, false, err
+		}
+	}
+
+	entry := hashCacheEntry{
+		Size:    info.Size(),
+		ModTime: info.ModTime().UnixNano(),
+		Mode:    info.Mode(),
+	}
+	if cached, ok := cache[path]; ok && cached.Size == entry.Size && cached.ModTime == entry.ModTime && cached.Mode == entry.Mode {
+		cached.Hash.Symlink = isSymlink
+		return cached, true, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return hashCacheEntry{}, false, err
+	}
+	defer f.Close()
+
+	h := sha1.New()
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return hashCacheEntry{}, false, err
+	}
+	entry.Hash = FileHash{
+		Size:    size,
+		Sha:     hex.EncodeToString(h.Sum(nil)),
+		Mode:    info.Mode(),
+		Symlink: isSymlink,
+	}
+	return entry, size == entry.Size && time.Since(info.ModTime()) > racyInterval, nil
+}
+
+// readHashCache reads a hash cache. Any problem reading the cache is treated as an empty cache,
+// as the hashes can always be recomputed.
+func readHashCache(path string) map[string]hashCacheEntry {
+	cache := map[string]hashCacheEntry{}
+	if path == "" {
+		return cache
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return cache
+	}
+	if err := json.Unmarshal(content, &cache); err != nil {
+		return map[string]hashCacheEntry{}
+	}
+	return cache
+}
+
+// writeHashCache replaces a hash cache, writing to a temporary file first so that concurrent
+// readers never see a partially written cache.
+func writeHashCache(path string, cache map[string]hashCacheEntry) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("could not create hash cache directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("could not write hash cache: %w", err)
+	}
+	err = json.NewEncoder(tmp).Encode(cache)
+	err = errors.Join(err, tmp.Close())
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("could not write hash cache: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package file
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestHashFiles(t *testing.T) {
+	dir := t.TempDir()
+	var paths []string
+	for i, content := range []string{"a", "bb", "ccc", ""} {
+		path := filepath.Join(dir, string(rune('a'+i))+".txt")
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, path)
+	}
+	executable := filepath.Join(dir, "run.sh")
+	if err := os.WriteFile(executable, []byte("#!/bin/sh"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	paths = append(paths, executable)
+
+	hashes, err := HashFiles(paths, HashOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rawSha := sha1.Sum(content)
+		want := hex.EncodeToString(rawSha[:])
+		if hashes[path].Sha != want || hashes[path].Size != int64(len(content)) {
+			t.Errorf("unexpected hash for %s: got %d~%s, want %d~%s", path, hashes[path].Size, hashes[path].Sha, len(content), want)
+		}
+	}
+	if hashes[executable].Mode.Perm()&0o111 == 0 {
+		t.Errorf("expected %s to be executable, got mode %s", executable, hashes[executable].Mode)
+	}
+}
+
+func TestHashFilesCache(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "index.html")
+	if err := os.WriteFile(path, []byte("hello"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// Files modified very recently are never cached, so backdate the file.
+	past := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(path, past, past); err != nil {
+		t.Fatal(err)
+	}
+	cacheFile := filepath.Join(dir, ".terraform", "cache.json")
+
+	if _, err := HashFiles([]string{path}, HashOptions{CacheFile: cacheFile}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Tamper with the cached hash, so we can tell whether the cache was used.
+	cache := readHashCache(cacheFile)
+	entry, ok := cache[path]
+	if !ok {
+		t.Fatalf("expected %s to be cached", path)
+	}
+	entry.Hash.Sha = "cached"
+	cache[path] = entry
+	content, err := json.Marshal(cache)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(cacheFile, content, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	hashes, err := HashFiles([]string{path}, HashOptions{CacheFile: cacheFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hashes[path].Sha != "cached" {
+		t.Errorf("expected an unchanged file to use the cache, got %s", hashes[path].Sha)
+	}
+
+	// Changing the modification time invalidates the cache entry.
+	past = past.Add(time.Minute)
+	if err := os.Chtimes(path, past, past); err != nil {
+		t.Fatal(err)
+	}
+	hashes, err = HashFiles([]string{path}, HashOptions{CacheFile: cacheFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hashes[path].Sha == "cached" {
+		t.Error("expected a modified file to be hashed again")
+	}
+}
@@ -2,12 +2,13 @@ package file
 
 import (
 	"bufio"
+	"bytes"
 	"errors"
 	"fmt"
 	"io/fs"
 	"os"
 	"path/filepath"
-	"strings"
+	"slices"
 )
 
 var defaultIgnores = []string{
@@ -41,24 +42,122 @@ var defaultIgnores = []string{
 	"*.tfstate.backup",
 }
 
-// GetIgnores is used to parse a .vercelignore file from a given directory, and
+const (
+	vercelIgnoreFile = ".vercelignore"
+	nowIgnoreFile    = ".nowignore"
+)
+
+// Ignores describes which files within a directory should not be uploaded as part of a deployment.
+//
+// The rules mirror `vercel deploy`:
+//   - The default ignores are applied first, so a negated pattern (e.g. `!.env.local`) in the
+//     ignore file can re-include a file that would otherwise be ignored by default.
+//   - Only the .vercelignore (or the legacy .nowignore) at the top of the directory is read.
+//     Nested ignore files and .gitignore files are not used.
+//   - Patterns are matched against paths relative to the directory, and once a directory is
+//     ignored nothing inside it can be re-included.
+//
+// The default ignores also include Terraform's own files, such as its state, which `vercel deploy` would upload.
+type Ignores struct {
+	patterns []pattern
+	// warnings describes any patterns in the ignore file that could not be compiled.
+	warnings []string
+}
+
+// Warnings describes any patterns in the ignore file that could not be compiled, and so are not used.
+func (i *Ignores) Warnings() []string {
+	return i.warnings
+}
+
+// GetIgnores is used to parse the ignore file from a given directory, and
 // combine the expected results with a default set of ignored files.
-func GetIgnores(path string) ([]string, error) {
-	ignoreFilePath := filepath.Join(path, ".vercelignore")
-	ignoreFile, err := os.ReadFile(ignoreFilePath)
+func GetIgnores(path string) (*Ignores, error) {
+	fileName, err := ignoreFileName(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []string
+	if fileName != "" {
+		lines, err = readIgnoreFile(filepath.Join(path, fileName))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	patterns, warnings := compilePatterns(append(slices.Clone(defaultIgnores), lines...))
+	return &Ignores{
+		patterns: patterns,
+		warnings: prefixWarnings(fileName, warnings),
+	}, nil
+}
+
+// ignoreFileName determines which ignore file should be used for a directory, returning an empty
+// string if there is none.
+func ignoreFileName(path string) (string, error) {
+	hasVercelIgnore, err := fileExists(filepath.Join(path, vercelIgnoreFile))
+	if err != nil {
+		return "", err
+	}
+	hasNowIgnore, err := fileExists(filepath.Join(path, nowIgnoreFile))
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case hasVercelIgnore && hasNowIgnore:
+		return "", fmt.Errorf("cannot use both a %s and %s file, please delete the %s file", vercelIgnoreFile, nowIgnoreFile, nowIgnoreFile)
+	case hasVercelIgnore:
+		return vercelIgnoreFile, nil
+	case hasNowIgnore:
+		return nowIgnoreFile, nil
+	default:
+		return "", nil
+	}
+}
+
+func fileExists(path string) (bool, error) {
+	_, err := os.Stat(path)
 	if errors.Is(err, fs.ErrNotExist) {
-		return defaultIgnores, nil
+		return false, nil
 	}
 	if err != nil {
-		return nil, fmt.Errorf("unable to read .vercelignore file: %w", err)
+		return false, fmt.Errorf("unable to read %s: %w", path, err)
 	}
+	return true, nil
+}
 
-	var ignores []string
-	sc := bufio.NewScanner(strings.NewReader(string(ignoreFile)))
+// readIgnoreFile returns the lines of an ignore file.
+func readIgnoreFile(path string) ([]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %s file: %w", filepath.Base(path), err)
+	}
+
+	var lines []string
+	sc := bufio.NewScanner(bytes.NewReader(content))
 	for sc.Scan() {
-		ignores = append(ignores, sc.Text())
+		lines = append(lines, sc.Text())
 	}
+	return lines, sc.Err()
+}
 
-	ignores = append(ignores, defaultIgnores...)
-	return ignores, nil
+// prefixWarnings prefixes each warning with the ignore file it came from.
+func prefixWarnings(file string, warnings []string) []string {
+	for n, w := range warnings {
+		warnings[n] = file + ": " + w
+	}
+	return warnings
+}
+
+// ignored reports whether a slash separated path, relative to the base path, should be ignored.
+// The last matching pattern wins.
+func (i *Ignores) ignored(rel string, isDir bool) bool {
+	ignored := false
+	for _, p := range i.patterns {
+		if p.matches(rel, isDir) {
+			ignored = !p.negate
+		}
+	}
+	return ignored
 }
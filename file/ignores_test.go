@@ -0,0 +1,185 @@
+package file
+
+import (
+	"flag"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// updateFromCLI rewrites the `want` section of each archive with the files the Vercel CLI uploads for
+// it, using testdata/ignores/list-files.mjs. This needs node, and the @vercel/client package that
+// `vercel deploy` uses to build its file list:
+//
+//	npm install --prefix /tmp/vercel-client @vercel/client
+//	NODE_PATH=/tmp/vercel-client/node_modules go test ./file -run TestGetPathsGolden -update-from-cli
+var updateFromCLI = flag.Bool("update-from-cli", false, "regenerate the ignore golden files with the Vercel CLI")
+
+// TestGetPathsGolden runs each archive in testdata/ignores against GetIgnores and GetPaths.
+// An archive describes a directory tree, using the same layout as golang.org/x/tools/txtar,
+// and the list of files `vercel deploy` uploads for it in a `want` section.
+func TestGetPathsGolden(t *testing.T) {
+	archives, err := filepath.Glob(filepath.Join("testdata", "ignores", "*.txtar"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, archive := range archives {
+		t.Run(strings.TrimSuffix(filepath.Base(archive), ".txtar"), func(t *testing.T) {
+			files, want := readArchive(t, archive)
+			dir := t.TempDir()
+			for name, content := range files {
+				path := filepath.Join(dir, filepath.FromSlash(name))
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if *updateFromCLI {
+				want = listFilesWithCLI(t, dir)
+				writeArchiveWant(t, archive, want)
+			}
+
+			got := getRelativePaths(t, dir, PathOptions{})
+			if !slices.Equal(got, want) {
+				t.Errorf("unexpected paths\ngot:\n  %s\nwant:\n  %s", strings.Join(got, "\n  "), strings.Join(want, "\n  "))
+			}
+		})
+	}
+}
+
+func TestGetIgnoresInvalidPatterns(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ".vercelignore"), []byte("*.log\n[z-a].txt\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ignores, err := GetIgnores(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := GetPaths(dir, ignores, PathOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	warnings := ignores.Warnings()
+	if len(warnings) != 1 || !strings.HasPrefix(warnings[0], `.vercelignore: invalid pattern "[z-a].txt"`) {
+		t.Errorf("unexpected warnings: %q", warnings)
+	}
+}
+
+// TestGetIgnoresTerraformFiles checks the files that are ignored by default in addition to those
+// `vercel deploy` ignores, so are not part of the golden files.
+func TestGetIgnoresTerraformFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{
+		"index.js",
+		".terraform.lock.hcl",
+		".terraform/providers/p",
+		".vercel_build_output/a",
+		"terraform.tfstate",
+		"terraform.tfstate.backup",
+	} {
+		writeFile(t, filepath.Join(dir, filepath.FromSlash(name)))
+	}
+
+	got := getRelativePaths(t, dir, PathOptions{})
+	if want := []string{"index.js"}; !slices.Equal(got, want) {
+		t.Errorf("unexpected paths\ngot:  %v\nwant: %v", got, want)
+	}
+}
+
+func TestGetIgnoresConflictingIgnoreFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{".vercelignore", ".nowignore"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err := GetIgnores(dir)
+	if err == nil {
+		t.Fatal("expected an error when both a .vercelignore and .nowignore are present")
+	}
+}
+
+// readArchive parses a txtar archive, returning the files it contains and the sorted lines
+// of its `want` section.
+func readArchive(t *testing.T, path string) (files map[string]string, want []string) {
+	t.Helper()
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files = map[string]string{}
+	name := ""
+	for _, line := range strings.SplitAfter(string(content), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "-- ") && strings.HasSuffix(trimmed, " --") {
+			name = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(trimmed, "-- "), " --"))
+			files[name] = ""
+			continue
+		}
+		if name != "" {
+			files[name] += line
+		}
+	}
+
+	for _, line := range strings.Split(files["want"], "\n") {
+		if line != "" {
+			want = append(want, line)
+		}
+	}
+	delete(files, "want")
+	slices.Sort(want)
+	return files, want
+}
+
+// listFilesWithCLI returns the sorted files the Vercel CLI would upload for a directory.
+func listFilesWithCLI(t *testing.T, dir string) []string {
+	t.Helper()
+	script, err := filepath.Abs(filepath.Join("testdata", "ignores", "list-files.mjs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := exec.Command("node", script, dir).Output()
+	if err != nil {
+		t.Fatalf("unable to list files with the Vercel CLI: %s", err)
+	}
+	var files []string
+	for _, line := range strings.Split(string(out), "\n") {
+		if line != "" {
+			files = append(files, line)
+		}
+	}
+	slices.Sort(files)
+	return files
+}
+
+// writeArchiveWant replaces the `want` section of an archive.
+func writeArchiveWant(t *testing.T, path string, want []string) {
+	t.Helper()
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const header = "-- want --\n"
+	start := strings.Index(string(content), header)
+	if start < 0 {
+		t.Fatalf("%s has no want section", path)
+	}
+	start += len(header)
+	end := strings.Index(string(content[start:]), "\n-- ")
+	if end < 0 {
+		t.Fatalf("%s has no files after its want section", path)
+	}
+	updated := string(content[:start]) + strings.Join(want, "\n") + string(content[start+end:])
+	if err := os.WriteFile(path, []byte(updated), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,197 @@
+package file
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// pattern is a single compiled line from an ignore file. Patterns follow the
+// gitignore specification, which is also what the Vercel CLI uses to evaluate
+// a .vercelignore file.
+type pattern struct {
+	re      *regexp.Regexp
+	negate  bool
+	dirOnly bool
+}
+
+// matches reports whether a slash separated path, relative to the directory
+// containing the ignore file, is matched by the pattern.
+func (p pattern) matches(rel string, isDir bool) bool {
+	if p.dirOnly && !isDir {
+		return false
+	}
+	return p.re.MatchString(rel)
+}
+
+// Matcher matches slash separated paths against a set of patterns, using the same syntax as a
+// .vercelignore file. The last matching pattern wins, so negated patterns can be used to
+// exclude paths matched by an earlier pattern.
+type Matcher struct {
+	patterns []pattern
+	warnings []string
+}
+
+// NewMatcher compiles a set of patterns into a Matcher. Invalid patterns are skipped, and reported
+// by Warnings.
+func NewMatcher(patterns []string) Matcher {
+	compiled, warnings := compilePatterns(patterns)
+	return Matcher{patterns: compiled, warnings: warnings}
+}
+
+// Warnings describes any patterns that could not be compiled, and so are not used for matching.
+func (m Matcher) Warnings() []string {
+	return m.warnings
+}
+
+// Empty reports whether the Matcher has no patterns.
+func (m Matcher) Empty() bool {
+	return len(m.patterns) == 0
+}
+
+// Matches reports whether a file, or one of its parent directories, matches the patterns.
+// A pattern such as `apps/web` therefore matches everything beneath that directory.
+func (m Matcher) Matches(rel string) bool {
+	parts := strings.Split(rel, "/")
+	matched := false
+	for _, p := range m.patterns {
+		for depth := 1; depth <= len(parts); depth++ {
+			if p.matches(strings.Join(parts[:depth], "/"), depth < len(parts)) {
+				matched = !p.negate
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// compilePatterns parses the lines of an ignore file, skipping blank lines
+// and comments. Lines that are not valid patterns are skipped, and described
+// in the returned warnings.
+func compilePatterns(lines []string) (patterns []pattern, warnings []string) {
+	for _, line := range lines {
+		p, ok, err := compilePattern(line)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("invalid pattern %q: %s", line, err))
+			continue
+		}
+		if ok {
+			patterns = append(patterns, p)
+		}
+	}
+	return patterns, warnings
+}
+
+func compilePattern(line string) (p pattern, ok bool, err error) {
+	line = strings.TrimRight(line, "\r")
+	line = trimTrailingSpaces(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return p, false, nil
+	}
+
+	if strings.HasPrefix(line, "!") {
+		p.negate = true
+		line = line[1:]
+	} else if strings.HasPrefix(line, `\!`) || strings.HasPrefix(line, `\#`) {
+		line = line[1:]
+	}
+
+	// The Vercel CLI strips any leading "./" before handing the rules to the
+	// matcher, so "./dist" is the same as "dist".
+	line = strings.TrimPrefix(line, "./")
+
+	if strings.HasSuffix(line, "/") {
+		p.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	if line == "" {
+		return p, false, nil
+	}
+
+	// A slash at the beginning or in the middle of a pattern anchors it to the
+	// directory of the ignore file. Otherwise it can match at any depth.
+	anchored := strings.Contains(line, "/")
+	line = strings.TrimPrefix(line, "/")
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored {
+		b.WriteString("(?:.*/)?")
+	}
+	segments := strings.Split(line, "/")
+	afterGlobstar := false
+	for i, segment := range segments {
+		last := i == len(segments)-1
+		switch {
+		case segment == "**" && anchored && last:
+			if i > 0 {
+				b.WriteString("/")
+			}
+			b.WriteString(".+")
+		case segment == "**" && anchored:
+			if i > 0 {
+				b.WriteString("/")
+			}
+			b.WriteString("(?:.*/)?")
+			afterGlobstar = true
+			continue
+		default:
+			if i > 0 && !afterGlobstar {
+				b.WriteString("/")
+			}
+			b.WriteString(globToRegexp(segment))
+		}
+		afterGlobstar = false
+	}
+	b.WriteString("$")
+
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return p, false, err
+	}
+	p.re = re
+	return p, true, nil
+}
+
+// trimTrailingSpaces removes trailing spaces from a line, unless they are escaped
+// with a backslash.
+func trimTrailingSpaces(line string) string {
+	for strings.HasSuffix(line, " ") && !strings.HasSuffix(line, `\ `) {
+		line = line[:len(line)-1]
+	}
+	return line
+}
+
+// globToRegexp converts a single path segment of a glob into a regular expression.
+func globToRegexp(segment string) string {
+	var b strings.Builder
+	for i := 0; i < len(segment); i++ {
+		c := segment[i]
+		switch c {
+		case '*':
+			b.WriteString("[^/]*")
+		case '?':
+			b.WriteString("[^/]")
+		case '\\':
+			if i+1 < len(segment) {
+				i++
+				b.WriteString(regexp.QuoteMeta(string(segment[i])))
+			}
+		case '[':
+			end := strings.IndexByte(segment[i+1:], ']')
+			if end < 0 {
+				b.WriteString(`\[`)
+				continue
+			}
+			class := segment[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			b.WriteString("[" + strings.ReplaceAll(class, `\`, `\\`) + "]")
+			i += end + 1
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return b.String()
+}
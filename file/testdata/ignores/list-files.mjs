@@ -0,0 +1,15 @@
+// Prints the files `vercel deploy` uploads for a directory, one per line, relative to the
+// directory. Used by `go test ./file -update-from-cli` to regenerate the `want` sections.
+//
+// Usage: NODE_PATH=<dir containing @vercel/client> node list-files.mjs <directory>
+import { createRequire } from 'node:module';
+import path from 'node:path';
+
+const require = createRequire(import.meta.url);
+const { buildFileTree } = require('@vercel/client/dist/utils/index.js');
+
+const dir = path.resolve(process.argv[2]);
+const { fileList } = await buildFileTree(dir, { isDirectory: true }, () => {});
+for (const file of fileList) {
+  console.log(path.relative(dir, file).split(path.sep).join('/'));
+}
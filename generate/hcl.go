@@ -0,0 +1,282 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// generator accumulates the resource, import and variable blocks for a team.
+type generator struct {
+	client        *client.Client
+	teamID        string
+	includeValues bool
+	file          *hclwrite.File
+	// labels holds the names already used for each resource type, and for variables.
+	labels map[string]map[string]bool
+	// projects maps a project ID to the name of its resource block, so other resources can
+	// reference the project rather than hard coding its ID.
+	projects  map[string]string
+	resources int
+}
+
+func newGenerator(c *client.Client, teamID string, includeValues bool) *generator {
+	return &generator{
+		client:        c,
+		teamID:        teamID,
+		includeValues: includeValues,
+		file:          hclwrite.NewEmptyFile(),
+		labels:        map[string]map[string]bool{},
+		projects:      map[string]string{},
+	}
+}
+
+// bytes returns the formatted configuration.
+func (g *generator) bytes() []byte {
+	return hclwrite.Format(g.file.Bytes())
+}
+
+// label converts a name into a valid, unique identifier for a block of the given kind.
+func (g *generator) label(kind, name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	label := strings.Trim(b.String(), "_")
+	if label == "" || (label[0] >= '0' && label[0] <= '9') {
+		label = "_" + label
+	}
+
+	if g.labels[kind] == nil {
+		g.labels[kind] = map[string]bool{}
+	}
+	unique := label
+	for i := 2; g.labels[kind][unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+	g.labels[kind][unique] = true
+	return unique
+}
+
+// addResource appends a resource block, followed by an import block that imports the existing
+// resource into it.
+func (g *generator) addResource(resourceType, label, importID string) *hclwrite.Body {
+	body := g.file.Body()
+	if g.resources > 0 {
+		body.AppendNewline()
+	}
+	resource := body.AppendNewBlock("resource", []string{resourceType, label}).Body()
+
+	body.AppendNewline()
+	imp := body.AppendNewBlock("import", nil).Body()
+	imp.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: label},
+	})
+	imp.SetAttributeValue("id", cty.StringVal(importID))
+
+	g.resources++
+	return resource
+}
+
+// setValue sets the value of an environment variable. Unless values are included, or the value
+// is not readable, a sensitive variable is declared to hold the value instead.
+func (g *generator) setValue(body *hclwrite.Body, name, value string, readable bool) {
+	if g.includeValues && readable {
+		body.SetAttributeValue("value", cty.StringVal(value))
+		return
+	}
+
+	variable := g.label("variable", name)
+	g.file.Body().AppendNewline()
+	v := g.file.Body().AppendNewBlock("variable", []string{variable}).Body()
+	v.SetAttributeRaw("type", hclwrite.TokensForIdentifier("string"))
+	v.SetAttributeValue("sensitive", cty.True)
+
+	body.SetAttributeTraversal("value", hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: variable},
+	})
+}
+
+// projectID returns a reference to the ID of a generated project, or the ID itself if the project
+// was not generated.
+func (g *generator) projectID(projectID string) hclwrite.Tokens {
+	label, ok := g.projects[projectID]
+	if !ok {
+		return hclwrite.TokensForValue(cty.StringVal(projectID))
+	}
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: "vercel_project"},
+		hcl.TraverseAttr{Name: label},
+		hcl.TraverseAttr{Name: "id"},
+	})
+}
+
+func setOptionalString(body *hclwrite.Body, name string, value *string) {
+	if value != nil && *value != "" {
+		body.SetAttributeValue(name, cty.StringVal(*value))
+	}
+}
+
+func setStringSet(body *hclwrite.Body, name string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+	vals := make([]cty.Value, 0, len(values))
+	for _, v := range values {
+		vals = append(vals, cty.StringVal(v))
+	}
+	body.SetAttributeValue(name, cty.SetVal(vals))
+}
+
+func (g *generator) addProject(p client.ProjectResponse) {
+	label := g.label("vercel_project", p.Name)
+	g.projects[p.ID] = label
+
+	body := g.addResource("vercel_project", label, fmt.Sprintf("%s/%s", g.teamID, p.ID))
+	body.SetAttributeValue("name", cty.StringVal(p.Name))
+	setOptionalString(body, "framework", p.Framework)
+	setOptionalString(body, "root_directory", p.RootDirectory)
+	setOptionalString(body, "build_command", p.BuildCommand)
+	setOptionalString(body, "dev_command", p.DevCommand)
+	setOptionalString(body, "install_command", p.InstallCommand)
+	setOptionalString(body, "output_directory", p.OutputDirectory)
+	setOptionalString(body, "ignore_command", p.CommandForIgnoringBuildStep)
+
+	if repo := p.Repository(); repo != nil {
+		attrs := map[string]cty.Value{
+			"type": cty.StringVal(repo.Type),
+			"repo": cty.StringVal(repo.Repo),
+		}
+		if repo.ProductionBranch != nil {
+			attrs["production_branch"] = cty.StringVal(*repo.ProductionBranch)
+		}
+		body.SetAttributeValue("git_repository", cty.ObjectVal(attrs))
+	}
+}
+
+func (g *generator) addProjectDomain(p client.ProjectResponse, d client.ProjectDomainResponse) {
+	label := g.label("vercel_project_domain", d.Name)
+	body := g.addResource("vercel_project_domain", label, fmt.Sprintf("%s/%s/%s", g.teamID, p.ID, d.Name))
+	body.SetAttributeRaw("project_id", g.projectID(p.ID))
+	body.SetAttributeValue("domain", cty.StringVal(d.Name))
+	setOptionalString(body, "redirect", d.Redirect)
+	if d.RedirectStatusCode != nil {
+		body.SetAttributeValue("redirect_status_code", cty.NumberIntVal(*d.RedirectStatusCode))
+	}
+	setOptionalString(body, "git_branch", d.GitBranch)
+	setOptionalString(body, "custom_environment_id", d.CustomEnvironmentID)
+}
+
+func (g *generator) addProjectEnvironmentVariable(p client.ProjectResponse, e client.EnvironmentVariable) {
+	name := fmt.Sprintf("%s_%s", g.projects[p.ID], e.Key)
+	label := g.label("vercel_project_environment_variable", name)
+	body := g.addResource("vercel_project_environment_variable", label, fmt.Sprintf("%s/%s/%s", g.teamID, p.ID, e.ID))
+	body.SetAttributeRaw("project_id", g.projectID(p.ID))
+	body.SetAttributeValue("key", cty.StringVal(e.Key))
+	g.setValue(body, name, e.Value, e.Type != "sensitive")
+	setStringSet(body, "target", e.Target)
+	setStringSet(body, "custom_environment_ids", e.CustomEnvironmentIDs)
+	setOptionalString(body, "git_branch", e.GitBranch)
+	body.SetAttributeValue("sensitive", cty.BoolVal(e.Type == "sensitive"))
+	setOptionalString(body, "comment", &e.Comment)
+}
+
+func (g *generator) addSharedEnvironmentVariable(e client.SharedEnvironmentVariableResponse) {
+	name := "shared_" + e.Key
+	label := g.label("vercel_shared_environment_variable", e.Key)
+	body := g.addResource("vercel_shared_environment_variable", label, fmt.Sprintf("%s/%s", g.teamID, e.ID))
+	body.SetAttributeValue("key", cty.StringVal(e.Key))
+	g.setValue(body, name, e.Value, e.Type != "sensitive")
+	setStringSet(body, "target", e.Target)
+
+	projectIDs := make([]hclwrite.Tokens, 0, len(e.ProjectIDs))
+	for _, id := range e.ProjectIDs {
+		projectIDs = append(projectIDs, g.projectID(id))
+	}
+	body.SetAttributeRaw("project_ids", hclwrite.TokensForTuple(projectIDs))
+	body.SetAttributeValue("sensitive", cty.BoolVal(e.Type == "sensitive"))
+	if e.ApplyToAllCustomEnvironments {
+		body.SetAttributeValue("apply_to_all_custom_environments", cty.True)
+	}
+	setOptionalString(body, "comment", &e.Comment)
+}
+
+func (g *generator) addDNSRecord(r client.DNSRecord) error {
+	name := r.Name
+	if name == "" {
+		name = "apex"
+	}
+	label := g.label("vercel_dns_record", fmt.Sprintf("%s_%s_%s", r.Domain, name, r.RecordType))
+	body := g.addResource("vercel_dns_record", label, fmt.Sprintf("%s/%s", g.teamID, r.ID))
+	body.SetAttributeValue("domain", cty.StringVal(r.Domain))
+	body.SetAttributeValue("name", cty.StringVal(r.Name))
+	body.SetAttributeValue("type", cty.StringVal(r.RecordType))
+
+	// MX, SRV and HTTPS records are returned with their additional fields as part of the value, so
+	// these need splitting back out in the same way the resource does.
+	switch r.RecordType {
+	case "MX":
+		priority, value, ok := strings.Cut(r.Value, " ")
+		p, err := strconv.ParseInt(priority, 10, 64)
+		if !ok || err != nil {
+			return fmt.Errorf("expected a 2 part value '{priority} {value}' for dns record %s, but got %s", r.ID, r.Value)
+		}
+		body.SetAttributeValue("mx_priority", cty.NumberIntVal(p))
+		body.SetAttributeValue("value", cty.StringVal(value))
+	case "SRV":
+		split := strings.Split(r.Value, " ")
+		if len(split) != 4 && len(split) != 3 {
+			return fmt.Errorf("expected a 3 or 4 part value '{priority} {weight} {port} {target}' for dns record %s, but got %s", r.ID, r.Value)
+		}
+		srv := map[string]cty.Value{}
+		for i, field := range []string{"priority", "weight", "port"} {
+			n, err := strconv.ParseInt(split[i], 10, 64)
+			if err != nil {
+				return fmt.Errorf("expected SRV record %s to be an int for dns record %s, but got %s", field, r.ID, split[i])
+			}
+			srv[field] = cty.NumberIntVal(n)
+		}
+		srv["target"] = cty.StringVal("")
+		if len(split) == 4 {
+			srv["target"] = cty.StringVal(split[3])
+		}
+		body.SetAttributeValue("srv", cty.ObjectVal(srv))
+	case "HTTPS":
+		split := strings.Fields(r.Value)
+		if len(split) < 2 {
+			return fmt.Errorf("expected a value '{priority} {target} {params}' for dns record %s, but got %s", r.ID, r.Value)
+		}
+		priority, err := strconv.ParseInt(split[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("expected HTTPS record priority to be an int for dns record %s, but got %s", r.ID, split[0])
+		}
+		https := map[string]cty.Value{
+			"priority": cty.NumberIntVal(priority),
+			"target":   cty.StringVal(split[1]),
+		}
+		if len(split) > 2 {
+			https["params"] = cty.StringVal(strings.Join(split[2:], " "))
+		}
+		body.SetAttributeValue("https", cty.ObjectVal(https))
+	default:
+		body.SetAttributeValue("value", cty.StringVal(r.Value))
+	}
+
+	if r.TTL != 0 {
+		body.SetAttributeValue("ttl", cty.NumberIntVal(r.TTL))
+	}
+	setOptionalString(body, "comment", &r.Comment)
+	return nil
+}
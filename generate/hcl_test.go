@@ -0,0 +1,130 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+func TestGenerator(t *testing.T) {
+	framework := "nextjs"
+	g := newGenerator(nil, "team_123", false)
+	p := client.ProjectResponse{ID: "prj_1", Name: "my-app", Framework: &framework}
+	g.addProject(p)
+	g.addProjectEnvironmentVariable(p, client.EnvironmentVariable{
+		ID:     "env_1",
+		Key:    "API_KEY",
+		Value:  "secret",
+		Type:   "encrypted",
+		Target: []string{"production"},
+	})
+	err := g.addDNSRecord(client.DNSRecord{
+		ID:         "rec_1",
+		Domain:     "example.com",
+		RecordType: "MX",
+		Value:      "10 mail.example.com.",
+		TTL:        60,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `resource "vercel_project" "my_app" {
+  name      = "my-app"
+  framework = "nextjs"
+}
+
+import {
+  to = vercel_project.my_app
+  id = "team_123/prj_1"
+}
+
+resource "vercel_project_environment_variable" "my_app_api_key" {
+  project_id = vercel_project.my_app.id
+  key        = "API_KEY"
+  value      = var.my_app_api_key
+  target     = ["production"]
+  sensitive  = false
+}
+
+import {
+  to = vercel_project_environment_variable.my_app_api_key
+  id = "team_123/prj_1/env_1"
+}
+
+variable "my_app_api_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "vercel_dns_record" "example_com_apex_mx" {
+  domain      = "example.com"
+  name        = ""
+  type        = "MX"
+  mx_priority = 10
+  value       = "mail.example.com."
+  ttl         = 60
+}
+
+import {
+  to = vercel_dns_record.example_com_apex_mx
+  id = "team_123/rec_1"
+}
+`
+	if got := string(g.bytes()); got != want {
+		t.Errorf("unexpected configuration:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestGeneratorLabel(t *testing.T) {
+	g := newGenerator(nil, "team_123", false)
+	for _, tc := range []struct {
+		name string
+		want string
+	}{
+		{name: "my-app", want: "my_app"},
+		{name: "my.app", want: "my_app_2"},
+		{name: "123-app", want: "_123_app"},
+		{name: "", want: "_"},
+	} {
+		if got := g.label("vercel_project", tc.name); got != tc.want {
+			t.Errorf("label(%q) = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestGeneratorHTTPSRecord(t *testing.T) {
+	g := newGenerator(nil, "team_123", false)
+	err := g.addDNSRecord(client.DNSRecord{
+		ID:         "rec_1",
+		Domain:     "example.com",
+		Name:       "www",
+		RecordType: "HTTPS",
+		Value:      "1 . alpn=h2,h3 ipv4hint=76.76.21.21",
+		TTL:        60,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `resource "vercel_dns_record" "example_com_www_https" {
+  domain = "example.com"
+  name   = "www"
+  type   = "HTTPS"
+  https = {
+    params   = "alpn=h2,h3 ipv4hint=76.76.21.21"
+    priority = 1
+    target   = "."
+  }
+  ttl = 60
+}
+
+import {
+  to = vercel_dns_record.example_com_www_https
+  id = "team_123/rec_1"
+}
+`
+	if got := string(g.bytes()); got != want {
+		t.Errorf("unexpected configuration:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+func main() {
+	// Generate Terraform configuration for the resources that already exist within a team.
+	// Each resource block is followed by an import block, so that running `terraform apply`
+	// brings the existing resources under management without having to write each block by hand.
+	team := flag.String("team", os.Getenv("VERCEL_TEAM"), "The ID or slug of the team to generate configuration for. Defaults to the VERCEL_TEAM environment variable.")
+	out := flag.String("out", "", "The file to write the configuration to. Defaults to stdout.")
+	includeValues := flag.Bool("include-values", false, "Write the decrypted values of environment variables into the configuration, rather than declaring a variable for each value.")
+	flag.Parse()
+
+	c := client.New(os.Getenv("VERCEL_API_TOKEN"))
+	if *team == "" {
+		//lintignore:R009
+		panic("-team flag or VERCEL_TEAM environment variable not set")
+	}
+	ctx := context.Background()
+
+	// Import IDs must use the team ID rather than the slug.
+	t, err := c.GetTeam(ctx, *team)
+	if err != nil {
+		//lintignore:R009
+		panic(fmt.Errorf("error getting team %s: %w", *team, err))
+	}
+
+	g := newGenerator(c, t.ID, *includeValues)
+	err = g.generateProjects(ctx)
+	if err != nil {
+		//lintignore:R009
+		panic(err)
+	}
+	err = g.generateSharedEnvironmentVariables(ctx)
+	if err != nil {
+		//lintignore:R009
+		panic(err)
+	}
+	err = g.generateDNSRecords(ctx)
+	if err != nil {
+		//lintignore:R009
+		panic(err)
+	}
+
+	if *out == "" {
+		_, err = os.Stdout.Write(g.bytes())
+	} else {
+		err = os.WriteFile(*out, g.bytes(), 0o644)
+	}
+	if err != nil {
+		//lintignore:R009
+		panic(err)
+	}
+	log.Printf("Generated configuration for %d resources", g.resources)
+}
+
+func (g *generator) generateProjects(ctx context.Context) error {
+	projects, err := g.client.ListProjects(ctx, g.teamID)
+	if err != nil {
+		return fmt.Errorf("error listing projects: %w", err)
+	}
+	slices.SortFunc(projects, func(a, b client.ProjectResponse) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	for _, p := range projects {
+		g.addProject(p)
+
+		domains, err := g.client.ListProjectDomains(ctx, p.ID, g.teamID)
+		if err != nil {
+			return fmt.Errorf("error listing domains for project %s: %w", p.Name, err)
+		}
+		for _, d := range domains {
+			g.addProjectDomain(p, d)
+		}
+
+		envs, err := g.client.GetEnvironmentVariables(ctx, p.ID, g.teamID)
+		if err != nil {
+			return fmt.Errorf("error listing environment variables for project %s: %w", p.Name, err)
+		}
+		for _, e := range envs {
+			if e.Type == "system" {
+				// System environment variables are managed by Vercel.
+				continue
+			}
+			g.addProjectEnvironmentVariable(p, e)
+		}
+		log.Printf("Generated project %s", p.Name)
+	}
+
+	return nil
+}
+
+func (g *generator) generateSharedEnvironmentVariables(ctx context.Context) error {
+	envs, err := g.client.ListSharedEnvironmentVariables(ctx, g.teamID)
+	if err != nil {
+		return fmt.Errorf("error listing shared environment variables: %w", err)
+	}
+	slices.SortFunc(envs, func(a, b client.SharedEnvironmentVariableResponse) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	for _, e := range envs {
+		// The list endpoint doesn't return decrypted values, so fetch each variable individually.
+		if g.includeValues && e.Type != "sensitive" {
+			decrypted, err := g.client.GetSharedEnvironmentVariable(ctx, g.teamID, e.ID)
+			if err != nil {
+				return fmt.Errorf("error getting shared environment variable %s: %w", e.Key, err)
+			}
+			e.Value = decrypted.Value
+		}
+		g.addSharedEnvironmentVariable(e)
+	}
+
+	return nil
+}
+
+func (g *generator) generateDNSRecords(ctx context.Context) error {
+	domains, err := g.client.ListDomains(ctx, g.teamID)
+	if err != nil {
+		return fmt.Errorf("error listing domains: %w", err)
+	}
+
+	for _, d := range domains {
+		if d.ServiceType != "zeit.world" {
+			// Only domains using Vercel's nameservers have DNS records managed by Vercel.
+			continue
+		}
+		records, err := g.client.ListDNSRecords(ctx, d.Name, g.teamID)
+		if err != nil {
+			return fmt.Errorf("error listing dns records for domain %s: %w", d.Name, err)
+		}
+		for _, r := range records {
+			if r.Creator == "system" {
+				// Default records are created and managed by Vercel.
+				continue
+			}
+			r.Domain = d.Name
+			err = g.addDNSRecord(r)
+			if err != nil {
+				return err
+			}
+		}
+		log.Printf("Generated dns records for domain %s", d.Name)
+	}
+
+	return nil
+}
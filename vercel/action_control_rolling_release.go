@@ -0,0 +1,186 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+var (
+	_ action.Action              = &controlRollingReleaseAction{}
+	_ action.ActionWithConfigure = &controlRollingReleaseAction{}
+)
+
+func newControlRollingReleaseAction() action.Action {
+	return &controlRollingReleaseAction{}
+}
+
+type controlRollingReleaseAction struct {
+	client *client.Client
+}
+
+func (a *controlRollingReleaseAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_control_rolling_release"
+}
+
+func (a *controlRollingReleaseAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *controlRollingReleaseAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Controls the rolling release in progress for a Project.
+
+The ` + "`operation`" + ` can be one of:
+- ` + "`approve`" + `: advance the rolling release to the next stage.
+- ` + "`complete`" + `: send all traffic to the canary deployment, skipping any remaining stages.
+- ` + "`abort`" + `: roll production back to the deployment that traffic is being shifted from.
+
+The status of a rolling release can be read with the ` + "`vercel_project_rolling_release_status`" + ` data source.
+
+Actions are supported in Terraform 1.14 and later. The action can be invoked from the ` + "`action_trigger`" + ` block of a resource's ` + "`lifecycle`" + `, or directly with ` + "`terraform apply -invoke`" + `.
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the Project.",
+				Required:    true,
+			},
+			"team_id": schema.StringAttribute{
+				Description: "The ID of the team the Project exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+				Optional:    true,
+			},
+			"operation": schema.StringAttribute{
+				Description: "The operation to perform. One of `approve`, `complete` or `abort`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("approve", "complete", "abort"),
+				},
+			},
+			"canary_deployment_id": schema.StringAttribute{
+				Description: "If set, the operation is only performed if the rolling release in progress is shifting traffic to this deployment. This prevents acting on a newer rolling release than intended.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+type ControlRollingRelease struct {
+	ProjectID          types.String `tfsdk:"project_id"`
+	TeamID             types.String `tfsdk:"team_id"`
+	Operation          types.String `tfsdk:"operation"`
+	CanaryDeploymentID types.String `tfsdk:"canary_deployment_id"`
+}
+
+// Invoke reads the rolling release in progress and approves, completes or aborts it.
+func (a *controlRollingReleaseAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config ControlRollingRelease
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := config.ProjectID.ValueString()
+	teamID := config.TeamID.ValueString()
+	rr, err := a.client.GetActiveRollingRelease(ctx, projectID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error controlling rolling release",
+			"Could not read the rolling release in progress, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if rr == nil || rr.State != "ACTIVE" || rr.CanaryDeployment == nil {
+		resp.Diagnostics.AddError(
+			"Error controlling rolling release",
+			fmt.Sprintf("Project %s does not have a rolling release in progress.", projectID),
+		)
+		return
+	}
+	canaryID := rr.CanaryDeployment.ID
+	if !config.CanaryDeploymentID.IsNull() && config.CanaryDeploymentID.ValueString() != canaryID {
+		resp.Diagnostics.AddError(
+			"Error controlling rolling release",
+			fmt.Sprintf(
+				"The rolling release in progress is shifting traffic to deployment %s, not %s.",
+				canaryID,
+				config.CanaryDeploymentID.ValueString(),
+			),
+		)
+		return
+	}
+
+	var message string
+	switch config.Operation.ValueString() {
+	case "approve":
+		if rr.NextStage == nil {
+			resp.Diagnostics.AddError(
+				"Error controlling rolling release",
+				"The rolling release is at its final stage. Use the `complete` operation to finish it.",
+			)
+			return
+		}
+		_, err = a.client.ApproveRollingReleaseStage(ctx, client.ApproveRollingReleaseStageRequest{
+			ProjectID:          projectID,
+			TeamID:             teamID,
+			NextStageIndex:     rr.NextStage.Index,
+			CanaryDeploymentID: canaryID,
+		})
+		message = fmt.Sprintf("Rolling release advanced to stage %d, sending %d%% of traffic to %s", rr.NextStage.Index, rr.NextStage.TargetPercentage, canaryID)
+	case "complete":
+		_, err = a.client.CompleteRollingRelease(ctx, client.CompleteRollingReleaseRequest{
+			ProjectID:          projectID,
+			TeamID:             teamID,
+			CanaryDeploymentID: canaryID,
+		})
+		message = fmt.Sprintf("Rolling release completed, sending all traffic to %s", canaryID)
+	case "abort":
+		if rr.CurrentDeployment == nil {
+			resp.Diagnostics.AddError(
+				"Error controlling rolling release",
+				"Could not abort the rolling release, as the deployment to roll back to is unknown.",
+			)
+			return
+		}
+		err = a.client.RollbackProject(ctx, projectID, teamID, rr.CurrentDeployment.ID)
+		message = fmt.Sprintf("Rolling release aborted, rolled back to %s", rr.CurrentDeployment.ID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error controlling rolling release",
+			fmt.Sprintf("Could not %s rolling release, unexpected error: %s", config.Operation.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "controlled rolling release", map[string]any{
+		"project_id":           projectID,
+		"operation":            config.Operation.ValueString(),
+		"canary_deployment_id": canaryID,
+	})
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: message,
+	})
+}
@@ -0,0 +1,198 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+func testAccRollingReleaseCompleted(testClient *client.Client, n, teamID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		rr, err := testClient.GetActiveRollingRelease(context.TODO(), rs.Primary.ID, teamID)
+		if err != nil {
+			return err
+		}
+		if rr != nil && rr.State == "ACTIVE" {
+			return fmt.Errorf("expected the rolling release to be completed, but it is still active")
+		}
+		return nil
+	}
+}
+
+func TestAcc_ControlRollingReleaseActionNoRollout(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(terraformVersion1_14_0),
+		},
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccControlRollingReleaseActionConfig(nameSuffix, `
+resource "terraform_data" "approve" {
+  input = vercel_project_rolling_release.test.project_id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.vercel_control_rolling_release.approve]
+    }
+  }
+}
+
+action "vercel_control_rolling_release" "approve" {
+  config {
+    project_id = vercel_project.test.id
+    operation  = "approve"
+  }
+}
+`)),
+				ExpectError: regexp.MustCompile(`does\s+not\s+have\s+a\s+rolling\s+release\s+in\s+progress`),
+			},
+		},
+	})
+}
+
+func TestAcc_ControlRollingReleaseAction(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(terraformVersion1_14_0),
+		},
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				// The first production deployment is released immediately.
+				Config: cfg(testAccControlRollingReleaseActionConfig(nameSuffix, "")),
+			},
+			{
+				// The second production deployment starts a rolling release, but it is not shifting
+				// traffic to the first deployment.
+				Config: cfg(testAccControlRollingReleaseActionConfig(nameSuffix, `
+resource "vercel_deployment" "second" {
+  project_id  = vercel_project.test.id
+  files       = data.vercel_file.test.file
+  path_prefix = "examples/functions"
+  production  = true
+  environment = {
+    RELEASE = "second"
+  }
+
+  depends_on = [vercel_deployment.first]
+}
+
+resource "terraform_data" "mismatch" {
+  input = vercel_deployment.second.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.vercel_control_rolling_release.mismatch]
+    }
+  }
+}
+
+action "vercel_control_rolling_release" "mismatch" {
+  config {
+    project_id           = vercel_project.test.id
+    operation            = "complete"
+    canary_deployment_id = vercel_deployment.first.id
+  }
+}
+`)),
+				ExpectError: regexp.MustCompile(`The\s+rolling\s+release\s+in\s+progress\s+is\s+shifting\s+traffic\s+to\s+deployment`),
+			},
+			{
+				Config: cfg(testAccControlRollingReleaseActionConfig(nameSuffix, `
+resource "vercel_deployment" "second" {
+  project_id  = vercel_project.test.id
+  files       = data.vercel_file.test.file
+  path_prefix = "examples/functions"
+  production  = true
+  environment = {
+    RELEASE = "second"
+  }
+
+  depends_on = [vercel_deployment.first]
+}
+
+resource "terraform_data" "complete" {
+  input = vercel_deployment.second.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.vercel_control_rolling_release.complete]
+    }
+  }
+}
+
+action "vercel_control_rolling_release" "complete" {
+  config {
+    project_id           = vercel_project.test.id
+    operation            = "complete"
+    canary_deployment_id = vercel_deployment.second.id
+  }
+}
+`)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccRollingReleaseCompleted(testClient(t), "vercel_project.test", testTeam(t)),
+				),
+			},
+		},
+	})
+}
+
+func testAccControlRollingReleaseActionConfig(nameSuffix, extra string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name            = "test-acc-control-rolling-release-%[1]s"
+  skew_protection = "12 hours"
+}
+
+resource "vercel_project_rolling_release" "test" {
+  project_id       = vercel_project.test.id
+  advancement_type = "manual-approval"
+  stages = [
+    {
+      target_percentage = 20
+    },
+    {
+      target_percentage = 100
+    }
+  ]
+}
+
+data "vercel_file" "test" {
+  path = "examples/functions/api/hello.js"
+}
+
+resource "vercel_deployment" "first" {
+  project_id  = vercel_project.test.id
+  files       = data.vercel_file.test.file
+  path_prefix = "examples/functions"
+  production  = true
+
+  depends_on = [vercel_project_rolling_release.test]
+}
+%[2]s
+`, nameSuffix, extra)
+}
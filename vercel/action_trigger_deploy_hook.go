@@ -0,0 +1,119 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+var (
+	_ action.Action               = &triggerDeployHookAction{}
+	_ action.ActionWithConfigure  = &triggerDeployHookAction{}
+	_ action.ActionWithModifyPlan = &triggerDeployHookAction{}
+)
+
+func newTriggerDeployHookAction() action.Action {
+	return &triggerDeployHookAction{}
+}
+
+type triggerDeployHookAction struct {
+	client *client.Client
+}
+
+func (a *triggerDeployHookAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trigger_deploy_hook"
+}
+
+func (a *triggerDeployHookAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *triggerDeployHookAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Triggers a Deploy Hook, which queues a new deployment of the branch the Deploy Hook was created for.
+
+Actions are supported in Terraform 1.14 and later. The action can be invoked from the ` + "`action_trigger`" + ` block of a resource's ` + "`lifecycle`" + `, or directly with ` + "`terraform apply -invoke`" + `.
+`,
+		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Description: "The URL of the Deploy Hook, such as the `url` of a `vercel_project_deploy_hook` resource. This must be a Vercel Deploy Hook URL, starting with `https://api.vercel.com/v1/integrations/deploy/`.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+type TriggerDeployHook struct {
+	URL types.String `tfsdk:"url"`
+}
+
+// ModifyPlan checks that the URL is a Deploy Hook URL, so that invoking the action cannot send a request to an
+// arbitrary host.
+func (a *triggerDeployHookAction) ModifyPlan(ctx context.Context, req action.ModifyPlanRequest, resp *action.ModifyPlanResponse) {
+	// Prevent panic if the provider has not been configured.
+	if a.client == nil {
+		return
+	}
+
+	var config TriggerDeployHook
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || config.URL.IsUnknown() {
+		return
+	}
+
+	if err := a.client.ValidateDeployHookURL(config.URL.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("url"),
+			"Invalid Deploy Hook URL",
+			"The url is not a Vercel Deploy Hook URL: "+err.Error(),
+		)
+	}
+}
+
+// Invoke makes a POST request to the deploy hook URL.
+func (a *triggerDeployHookAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config TriggerDeployHook
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := a.client.TriggerDeployHook(ctx, config.URL.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error triggering Deploy Hook",
+			"Could not trigger Deploy Hook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "triggered deploy hook", map[string]any{
+		"job_id": job.ID,
+	})
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Deploy Hook triggered, deployment job %s is %s", job.ID, job.State),
+	})
+}
@@ -0,0 +1,51 @@
+package vercel_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// terraformVersion1_14_0 is the first Terraform version to support actions.
+var terraformVersion1_14_0 = version.Must(version.NewVersion("1.14.0"))
+
+func TestAcc_TriggerDeployHookActionInvalidURL(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(terraformVersion1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      cfg(testAccTriggerDeployHookActionConfig(acctest.RandString(16), "https://example.com/v1/integrations/deploy/prj_123/abc")),
+				ExpectError: regexp.MustCompile(`Invalid\s+Deploy\s+Hook\s+URL`),
+			},
+		},
+	})
+}
+
+func testAccTriggerDeployHookActionConfig(suffix, url string) string {
+	return fmt.Sprintf(`
+action "vercel_trigger_deploy_hook" "test" {
+  config {
+    url = "%[2]s"
+  }
+}
+
+resource "terraform_data" "test" {
+  input = "%[1]s"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.vercel_trigger_deploy_hook.test]
+    }
+  }
+}
+`, suffix, url)
+}
@@ -0,0 +1,23 @@
+package vercel
+
+import "strings"
+
+// certificateCoversDomain reports whether any of the names of a certificate is the domain, a subdomain of it,
+// or a wildcard such as `*.example.com` that matches the domain.
+func certificateCoversDomain(names []string, domain string) bool {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSuffix(name, "."))
+		if name == domain || strings.HasSuffix(name, "."+domain) {
+			return true
+		}
+		// A wildcard only matches a single label, so `*.example.com` covers `www.example.com`, but neither
+		// `example.com` itself (handled above as a subdomain) nor `a.www.example.com`.
+		if parent, ok := strings.CutPrefix(name, "*."); ok {
+			if _, domainParent, found := strings.Cut(domain, "."); found && domainParent == parent {
+				return true
+			}
+		}
+	}
+	return false
+}
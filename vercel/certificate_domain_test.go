@@ -0,0 +1,33 @@
+package vercel
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCertificateCoversDomain(t *testing.T) {
+	for _, tc := range []struct {
+		Names  []string
+		Domain string
+		Want   bool
+	}{
+		{Names: []string{"example.com"}, Domain: "example.com", Want: true},
+		{Names: []string{"Example.com."}, Domain: "example.com", Want: true},
+		{Names: []string{"www.example.com"}, Domain: "example.com", Want: true},
+		{Names: []string{"*.example.com"}, Domain: "example.com", Want: true},
+		{Names: []string{"*.example.com"}, Domain: "www.example.com", Want: true},
+		{Names: []string{"example.com", "*.example.com"}, Domain: "WWW.example.com.", Want: true},
+		{Names: []string{"*.example.com"}, Domain: "a.www.example.com", Want: false},
+		{Names: []string{"*.www.example.com"}, Domain: "example.com", Want: true},
+		{Names: []string{"example.com"}, Domain: "www.example.com", Want: false},
+		{Names: []string{"notexample.com"}, Domain: "example.com", Want: false},
+		{Names: []string{"*.example.org"}, Domain: "www.example.com", Want: false},
+		{Names: nil, Domain: "example.com", Want: false},
+	} {
+		t.Run(strings.Join(tc.Names, ",")+" "+tc.Domain, func(t *testing.T) {
+			if got := certificateCoversDomain(tc.Names, tc.Domain); got != tc.Want {
+				t.Errorf("expected %t for names %v, but got %t", tc.Want, tc.Names, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &certificatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &certificatesDataSource{}
+)
+
+func newCertificatesDataSource() datasource.DataSource {
+	return &certificatesDataSource{}
+}
+
+type certificatesDataSource struct {
+	client *client.Client
+}
+
+func (d *certificatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificates"
+}
+
+func (d *certificatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema returns the schema information for a certificates data source
+func (d *certificatesDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides information about the SSL certificates of a team, or of a single domain.
+
+This includes the certificates Vercel issues and renews automatically, as well as any uploaded with ` + "`vercel_custom_certificate`" + `. The expiry and auto-renew flag of each certificate can be used, for example in a ` + "`check`" + ` block, to alert when a certificate has failed to renew.
+`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team the certificates exist under. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"domain": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list certificates that cover this domain or any of its subdomains, such as `example.com`. A wildcard certificate such as `*.example.com` covers `www.example.com`. If unset, all the certificates of the team are listed.",
+			},
+			"certificates": schema.ListNestedAttribute{
+				Description: "The certificates, ordered by when they expire.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the certificate.",
+							Computed:    true,
+						},
+						"names": schema.ListAttribute{
+							Description: "The domain names the certificate covers.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The time the certificate was created, in RFC 3339 format.",
+							Computed:    true,
+						},
+						"expires_at": schema.StringAttribute{
+							Description: "The time the certificate expires, in RFC 3339 format.",
+							Computed:    true,
+						},
+						"days_until_expiry": schema.Int64Attribute{
+							Description: "The number of whole days until the certificate expires, at the time the data source was read. This is negative if the certificate has expired.",
+							Computed:    true,
+						},
+						"auto_renew": schema.BoolAttribute{
+							Description: "Whether Vercel automatically renews the certificate before it expires.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Certificates reflects the state terraform stores internally for the certificates of a team.
+type Certificates struct {
+	TeamID       types.String `tfsdk:"team_id"`
+	Domain       types.String `tfsdk:"domain"`
+	Certificates types.List   `tfsdk:"certificates"`
+}
+
+var certificatesElemType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":                types.StringType,
+		"names":             types.ListType{ElemType: types.StringType},
+		"created_at":        types.StringType,
+		"expires_at":        types.StringType,
+		"days_until_expiry": types.Int64Type,
+		"auto_renew":        types.BoolType,
+	},
+}
+
+func convertResponseToCertificates(certs []client.CertificateResponse, domain types.String, teamID string, now time.Time) Certificates {
+	var matching []client.CertificateResponse
+	for _, c := range certs {
+		if domain.IsNull() || certificateCoversDomain(c.CNs, domain.ValueString()) {
+			matching = append(matching, c)
+		}
+	}
+	sort.SliceStable(matching, func(i, j int) bool {
+		return matching[i].ExpiresAt < matching[j].ExpiresAt
+	})
+
+	values := []attr.Value{}
+	for _, c := range matching {
+		expiresAt := time.UnixMilli(c.ExpiresAt)
+		values = append(values, types.ObjectValueMust(certificatesElemType.AttrTypes, map[string]attr.Value{
+			"id":                types.StringValue(c.ID),
+			"names":             toStringList(c.CNs),
+			"created_at":        types.StringValue(time.UnixMilli(c.CreatedAt).UTC().Format(time.RFC3339)),
+			"expires_at":        types.StringValue(expiresAt.UTC().Format(time.RFC3339)),
+			"days_until_expiry": types.Int64Value(int64(expiresAt.Sub(now).Hours() / 24)),
+			"auto_renew":        types.BoolValue(c.AutoRenew),
+		}))
+	}
+	return Certificates{
+		TeamID:       toTeamID(teamID),
+		Domain:       domain,
+		Certificates: types.ListValueMust(certificatesElemType, values),
+	}
+}
+
+// Read will read the certificates of a team by requesting them from the Vercel API, and will update terraform
+// with this information.
+func (d *certificatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config Certificates
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID := config.TeamID.ValueString()
+	certs, err := d.client.ListCertificates(ctx, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading certificates",
+			fmt.Sprintf("Could not read certificates %s, unexpected error: %s", teamID, err),
+		)
+		return
+	}
+
+	result := convertResponseToCertificates(certs, config.Domain, d.client.TeamID(teamID), time.Now())
+	tflog.Info(ctx, "read certificates", map[string]any{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Domain.ValueString(),
+		"count":   len(result.Certificates.Elements()),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
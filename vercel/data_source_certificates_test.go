@@ -0,0 +1,47 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_CertificatesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckCustomCertificateDoesNotExist(testClient(t), testTeam(t), "vercel_custom_certificate.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_custom_certificate" "test" {
+	private_key = <<EOT
+%[1]s
+EOT
+	certificate = <<EOT
+%[2]s
+EOT
+	certificate_authority_certificate = <<EOT
+%[2]s
+EOT
+}
+
+data "vercel_certificates" "test" {
+	depends_on = [vercel_custom_certificate.test]
+}
+
+data "vercel_certificates" "domain" {
+	domain = "%[3]s"
+}
+`, testCertKey(t), testCert(t), testDomain(t))),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair("data.vercel_certificates.test", "certificates.*.id", "vercel_custom_certificate.test", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.vercel_certificates.test", "certificates.*", map[string]string{
+						"auto_renew": "false",
+					}),
+					resource.TestCheckResourceAttrSet("data.vercel_certificates.domain", "certificates.#"),
+				),
+			},
+		},
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/vercel/terraform-provider-vercel/v3/client"
 )
@@ -88,6 +89,11 @@ An CustomEnvironment allows a ` + "`vercel_deployment` to be accessed through a
 					},
 				},
 			},
+			"branch_tracking_matches": schema.ListAttribute{
+				Description: "The branches of the project's most recent deployments that match `branch_tracking`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 		},
 	}
 }
@@ -126,7 +132,13 @@ func (d *customEnvironmentDataSource) Read(ctx context.Context, req datasource.R
 		"custom_environment_id": res.ID,
 	})
 
-	diags = resp.State.Set(ctx, convertResponseToModel(res))
+	result := convertResponseToModel(res)
+	// The matching branches are only informational, so they are left null if they can't be read.
+	if matches, diags := branchTrackingMatches(ctx, d.client, res.ProjectID, res.TeamID, res.BranchMatcher); !diags.HasError() {
+		result.BranchTrackingMatches = matches
+	}
+
+	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -0,0 +1,217 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &customEnvironmentsDataSource{}
+	_ datasource.DataSourceWithConfigure = &customEnvironmentsDataSource{}
+)
+
+func newCustomEnvironmentsDataSource() datasource.DataSource {
+	return &customEnvironmentsDataSource{}
+}
+
+type customEnvironmentsDataSource struct {
+	client *client.Client
+}
+
+func (d *customEnvironmentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_environments"
+}
+
+func (d *customEnvironmentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *customEnvironmentsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides information about all the Custom Environments of a Project.
+
+Each environment includes the domains assigned to it, and the number of environment variables that apply to it. Domains are assigned with the ` + "`custom_environment_id`" + ` of a ` + "`vercel_project_domain`" + `, and environment variables with the ` + "`custom_environment_ids`" + ` of a ` + "`vercel_project_environment_variable`" + `.
+`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team the Project exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the existing Vercel Project.",
+				Required:    true,
+			},
+			"environments": schema.ListNestedAttribute{
+				Description: "The Custom Environments of the Project.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the environment.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the environment.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "A description of what the environment is.",
+							Computed:    true,
+						},
+						"branch_tracking": schema.SingleNestedAttribute{
+							Description: "The branch tracking configuration for the environment.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"pattern": schema.StringAttribute{
+									Description: "The pattern of the branch name to track.",
+									Computed:    true,
+								},
+								"type": schema.StringAttribute{
+									Description: "How a branch name should be matched against the pattern. One of 'startsWith', 'endsWith' or 'equals'.",
+									Computed:    true,
+								},
+							},
+						},
+						"domains": schema.ListAttribute{
+							Description: "The domains assigned to the environment.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"environment_variable_count": schema.Int64Attribute{
+							Description: "The number of environment variables that apply to the environment.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// CustomEnvironments reflects the state terraform stores internally for the custom environments of a project.
+type CustomEnvironments struct {
+	TeamID       types.String `tfsdk:"team_id"`
+	ProjectID    types.String `tfsdk:"project_id"`
+	Environments types.List   `tfsdk:"environments"`
+}
+
+var customEnvironmentsElemType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":                         types.StringType,
+		"name":                       types.StringType,
+		"description":                types.StringType,
+		"branch_tracking":            branchTrackingAttrType,
+		"domains":                    types.ListType{ElemType: types.StringType},
+		"environment_variable_count": types.Int64Type,
+	},
+}
+
+func convertResponseToCustomEnvironments(
+	environments []client.CustomEnvironmentResponse,
+	domains []client.ProjectDomainResponse,
+	envs []client.EnvironmentVariable,
+	projectID, teamID string,
+) CustomEnvironments {
+	values := []attr.Value{}
+	for _, e := range environments {
+		environmentDomains := []attr.Value{}
+		for _, d := range domains {
+			if d.CustomEnvironmentID != nil && *d.CustomEnvironmentID == e.ID {
+				environmentDomains = append(environmentDomains, types.StringValue(d.Name))
+			}
+		}
+		count := 0
+		for _, v := range envs {
+			if contains(v.CustomEnvironmentIDs, e.ID) {
+				count++
+			}
+		}
+
+		model := convertResponseToModel(e)
+		values = append(values, types.ObjectValueMust(customEnvironmentsElemType.AttrTypes, map[string]attr.Value{
+			"id":                         model.ID,
+			"name":                       model.Name,
+			"description":                model.Description,
+			"branch_tracking":            model.BranchTracking,
+			"domains":                    types.ListValueMust(types.StringType, environmentDomains),
+			"environment_variable_count": types.Int64Value(int64(count)),
+		}))
+	}
+	return CustomEnvironments{
+		TeamID:       toTeamID(teamID),
+		ProjectID:    types.StringValue(projectID),
+		Environments: types.ListValueMust(customEnvironmentsElemType, values),
+	}
+}
+
+// Read will read the custom environments of a project, along with their domains and environment variables, by
+// requesting them from the Vercel API, and will update terraform with this information.
+func (d *customEnvironmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config CustomEnvironments
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := config.ProjectID.ValueString()
+	teamID := config.TeamID.ValueString()
+	environments, err := d.client.ListCustomEnvironments(ctx, projectID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading custom environments",
+			fmt.Sprintf("Could not read custom environments %s %s, unexpected error: %s", teamID, projectID, err),
+		)
+		return
+	}
+	domains, err := d.client.ListProjectDomains(ctx, projectID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading custom environments",
+			fmt.Sprintf("Could not read project domains %s %s, unexpected error: %s", teamID, projectID, err),
+		)
+		return
+	}
+	envs, err := d.client.ListEnvironmentVariables(ctx, projectID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading custom environments",
+			fmt.Sprintf("Could not read environment variables %s %s, unexpected error: %s", teamID, projectID, err),
+		)
+		return
+	}
+
+	result := convertResponseToCustomEnvironments(environments, domains, envs, projectID, d.client.TeamID(teamID))
+	tflog.Info(ctx, "read custom environments", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"count":      len(environments),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,86 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_CustomEnvironmentsDataSource(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccCustomEnvironmentsDataSource(projectSuffix, testDomain(t))),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_custom_environments.test", "environments.#", "1"),
+					resource.TestCheckResourceAttrPair("data.vercel_custom_environments.test", "environments.0.id", "vercel_custom_environment.test", "id"),
+					resource.TestCheckResourceAttr("data.vercel_custom_environments.test", "environments.0.name", fmt.Sprintf("test-acc-ce-%s", projectSuffix)),
+					resource.TestCheckResourceAttr("data.vercel_custom_environments.test", "environments.0.description", "staging"),
+					resource.TestCheckResourceAttr("data.vercel_custom_environments.test", "environments.0.branch_tracking.pattern", "staging-"),
+					resource.TestCheckResourceAttr("data.vercel_custom_environments.test", "environments.0.domains.#", "1"),
+					resource.TestCheckResourceAttr("data.vercel_custom_environments.test", "environments.0.domains.0", fmt.Sprintf("test-acc-ce-%s.%s", projectSuffix, testDomain(t))),
+					resource.TestCheckResourceAttr("data.vercel_custom_environments.test", "environments.0.environment_variable_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomEnvironmentsDataSource(projectSuffix, domain string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-custom-envs-data-source-%[1]s"
+}
+
+resource "vercel_custom_environment" "test" {
+  project_id  = vercel_project.test.id
+  name        = "test-acc-ce-%[1]s"
+  description = "staging"
+  branch_tracking = {
+    pattern = "staging-"
+    type    = "startsWith"
+  }
+}
+
+resource "vercel_project_domain" "test" {
+  project_id            = vercel_project.test.id
+  domain                = "test-acc-ce-%[1]s.%[2]s"
+  custom_environment_id = vercel_custom_environment.test.id
+}
+
+resource "vercel_project_environment_variables" "test" {
+  project_id = vercel_project.test.id
+  variables = [
+    {
+      key                    = "FOO"
+      value                  = "bar"
+      custom_environment_ids = [vercel_custom_environment.test.id]
+    },
+    {
+      key                    = "BAZ"
+      value                  = "qux"
+      custom_environment_ids = [vercel_custom_environment.test.id]
+    },
+    {
+      key    = "PREVIEW_ONLY"
+      value  = "preview"
+      target = ["preview"]
+    },
+  ]
+}
+
+data "vercel_custom_environments" "test" {
+  project_id = vercel_project.test.id
+
+  depends_on = [
+    vercel_project_domain.test,
+    vercel_project_environment_variables.test,
+  ]
+}
+`, projectSuffix, domain)
+}
@@ -0,0 +1,125 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dnsZoneDataSource{}
+	_ datasource.DataSourceWithConfigure = &dnsZoneDataSource{}
+)
+
+func newDNSZoneDataSource() datasource.DataSource {
+	return &dnsZoneDataSource{}
+}
+
+type dnsZoneDataSource struct {
+	client *client.Client
+}
+
+func (d *dnsZoneDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone"
+}
+
+func (d *dnsZoneDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema returns the schema information for a DNS zone data source
+func (d *dnsZoneDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides the DNS records of a domain as an RFC 1035 zone file.
+
+The default records created by Vercel are not included, so the zone file can be used as the ` + "`zone_file`" + ` of a ` + "`vercel_dns_zone`" + `, or to move the records to another DNS provider.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"domain": schema.StringAttribute{
+				Description: "The domain name, or zone, to export the DNS records of.",
+				Required:    true,
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team the domain exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"zone_file": schema.StringAttribute{
+				Description: "The DNS records of the domain, as an RFC 1035 zone file.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// DNSZoneDataSource reflects the state terraform stores internally for a DNS zone data source.
+type DNSZoneDataSource struct {
+	ID       types.String `tfsdk:"id"`
+	Domain   types.String `tfsdk:"domain"`
+	TeamID   types.String `tfsdk:"team_id"`
+	ZoneFile types.String `tfsdk:"zone_file"`
+}
+
+// Read will read the DNS records for a domain by requesting them from the Vercel API, and will update
+// terraform with a zone file of them.
+func (d *dnsZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DNSZoneDataSource
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := config.Domain.ValueString()
+	records, err := listDNSZoneRecords(ctx, d.client, domain, config.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNS records",
+			fmt.Sprintf("Could not list DNS records for %s %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				domain,
+				err,
+			),
+		)
+		return
+	}
+
+	result := DNSZoneDataSource{
+		ID:       types.StringValue(domain),
+		Domain:   types.StringValue(domain),
+		TeamID:   toTeamID(d.client.TeamID(config.TeamID.ValueString())),
+		ZoneFile: types.StringValue(renderZoneFile(domain, records)),
+	}
+	tflog.Info(ctx, "read dns zone", map[string]any{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  domain,
+		"records": len(records),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,181 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &domainDataSource{}
+	_ datasource.DataSourceWithConfigure = &domainDataSource{}
+)
+
+func newDomainDataSource() datasource.DataSource {
+	return &domainDataSource{}
+}
+
+type domainDataSource struct {
+	client *client.Client
+}
+
+func (d *domainDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain"
+}
+
+func (d *domainDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema returns the schema information for a domain data source
+func (d *domainDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides information about a Domain that has been added to a team.
+
+This includes whether the domain has been verified, the nameservers it uses and should use, and whether its DNS is served by Vercel.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the Domain.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The domain name, such as `example.com`.",
+				Required:    true,
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team the Domain exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"verified": schema.BoolAttribute{
+				Description: "Whether the domain has been verified.",
+				Computed:    true,
+			},
+			"uses_vercel_dns": schema.BoolAttribute{
+				Description: "Whether the domain's DNS is served by Vercel.",
+				Computed:    true,
+			},
+			"service_type": schema.StringAttribute{
+				Description: "How the domain's DNS is served. One of `zeit.world` (Vercel DNS), `external` or `na`.",
+				Computed:    true,
+			},
+			"nameservers": schema.ListAttribute{
+				Description: "The nameservers the domain currently uses.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"intended_nameservers": schema.ListAttribute{
+				Description: "The Vercel nameservers the domain should use for its DNS to be served by Vercel.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"custom_nameservers": schema.ListAttribute{
+				Description: "Any custom nameservers configured for the domain.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"verification_records": schema.ListNestedAttribute{
+				Description: "The DNS records that verify ownership of the domain, for domains that do not use Vercel's nameservers.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "The type of the DNS record.",
+							Computed:    true,
+						},
+						"domain": schema.StringAttribute{
+							Description: "The name of the DNS record.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "The value of the DNS record.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// DomainDataSource reflects the state terraform stores internally for a domain data source, which has no
+// options to wait for verification.
+type DomainDataSource struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	TeamID              types.String `tfsdk:"team_id"`
+	Verified            types.Bool   `tfsdk:"verified"`
+	UsesVercelDNS       types.Bool   `tfsdk:"uses_vercel_dns"`
+	ServiceType         types.String `tfsdk:"service_type"`
+	Nameservers         types.List   `tfsdk:"nameservers"`
+	IntendedNameservers types.List   `tfsdk:"intended_nameservers"`
+	CustomNameservers   types.List   `tfsdk:"custom_nameservers"`
+	VerificationRecords types.List   `tfsdk:"verification_records"`
+}
+
+// Read will read the domain information by requesting it from the Vercel API, and will update terraform
+// with this information.
+func (d *domainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DomainDataSource
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := d.client.GetDomain(ctx, config.Name.ValueString(), config.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domain",
+			fmt.Sprintf("Could not get domain %s %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				config.Name.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	domain := convertResponseToDomain(out, Domain{})
+	result := DomainDataSource{
+		ID:                  domain.ID,
+		Name:                domain.Name,
+		TeamID:              domain.TeamID,
+		Verified:            domain.Verified,
+		UsesVercelDNS:       domain.UsesVercelDNS,
+		ServiceType:         domain.ServiceType,
+		Nameservers:         domain.Nameservers,
+		IntendedNameservers: domain.IntendedNameservers,
+		CustomNameservers:   domain.CustomNameservers,
+		VerificationRecords: domain.VerificationRecords,
+	}
+	tflog.Info(ctx, "read domain", map[string]any{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Name.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
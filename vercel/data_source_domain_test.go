@@ -0,0 +1,30 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DomainDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(fmt.Sprintf(`
+data "vercel_domain" "test" {
+  name = "%s"
+}
+`, testDomain(t))),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vercel_domain.test", "id"),
+					resource.TestCheckResourceAttr("data.vercel_domain.test", "name", testDomain(t)),
+					resource.TestCheckResourceAttr("data.vercel_domain.test", "verified", "true"),
+					resource.TestCheckResourceAttrSet("data.vercel_domain.test", "service_type"),
+					resource.TestCheckResourceAttrSet("data.vercel_domain.test", "uses_vercel_dns"),
+				),
+			},
+		},
+	})
+}
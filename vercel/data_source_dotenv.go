@@ -0,0 +1,242 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/v3/file"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &dotenvDataSource{}
+)
+
+func newDotenvDataSource() datasource.DataSource {
+	return &dotenvDataSource{}
+}
+
+type dotenvDataSource struct{}
+
+func (d *dotenvDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dotenv"
+}
+
+// Schema returns the schema information for a dotenv data source
+func (d *dotenvDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Parses a dotenv file, such as ` + "`.env.production`" + `, into Environment Variables.
+
+The ` + "`variables`" + ` attribute can be passed directly to the ` + "`variables`" + ` field of a ` + "`vercel_project_environment_variables`" + ` resource.
+
+Values may be unquoted, or wrapped in single quotes, double quotes or backticks, and quoted values may span multiple lines. Lines may be prefixed with ` + "`export`" + `, and comments start with ` + "`#`" + `. References to variables defined earlier in the file, such as ` + "`${VAR}` or `${VAR:-default}`" + `, are expanded in unquoted and double quoted values.
+`,
+		Attributes: map[string]schema.Attribute{
+			"filename": schema.StringAttribute{
+				Description: "The path to the dotenv file. Note that the path is relative to the root of the terraform files.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("content")),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "The contents of a dotenv file, as an alternative to `filename`.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"target": schema.SetAttribute{
+				Description: "The environments that each Environment Variable should be present on. Valid targets are either `production`, `preview`, or `development`.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("production", "preview", "development")),
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"custom_environment_ids": schema.SetAttribute{
+				Description: "The IDs of Custom Environments that each Environment Variable should be present on.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"git_branch": schema.StringAttribute{
+				Description: "The git branch of each Environment Variable.",
+				Optional:    true,
+			},
+			"sensitive": schema.BoolAttribute{
+				Description: "Whether each Environment Variable should be sensitive, unless it matches `sensitive_keys` or `non_sensitive_keys`. If not set, the default of the resource the variables are passed to is used.",
+				Optional:    true,
+			},
+			"sensitive_keys": schema.SetAttribute{
+				Description: "Glob patterns, such as `*_SECRET`, for the keys of Environment Variables that should always be sensitive. These take precedence over `non_sensitive_keys`.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validateGlobPattern()),
+				},
+			},
+			"non_sensitive_keys": schema.SetAttribute{
+				Description: "Glob patterns, such as `NEXT_PUBLIC_*`, for the keys of Environment Variables that should never be sensitive.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validateGlobPattern()),
+				},
+			},
+			"map": schema.MapAttribute{
+				Description: "A map of each Environment Variable key to its value.",
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+			"variables": schema.ListNestedAttribute{
+				Description: "The Environment Variables, in the order they are defined in the file. This has the same shape as the `variables` field of a `vercel_project_environment_variables` resource.",
+				Computed:    true,
+				Sensitive:   true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Description: "The name of the Environment Variable.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "The value of the Environment Variable.",
+							Computed:    true,
+						},
+						"target": schema.SetAttribute{
+							Description: "The environments that the Environment Variable should be present on.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"custom_environment_ids": schema.SetAttribute{
+							Description: "The IDs of Custom Environments that the Environment Variable should be present on.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"git_branch": schema.StringAttribute{
+							Description: "The git branch of the Environment Variable.",
+							Computed:    true,
+						},
+						"sensitive": schema.BoolAttribute{
+							Description: "Whether the Environment Variable is sensitive or not.",
+							Computed:    true,
+						},
+						"comment": schema.StringAttribute{
+							Description: "A comment explaining what the environment variable is for. This is always null.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type DotenvVariableItem struct {
+	Key                  types.String `tfsdk:"key"`
+	Value                types.String `tfsdk:"value"`
+	Target               types.Set    `tfsdk:"target"`
+	CustomEnvironmentIDs types.Set    `tfsdk:"custom_environment_ids"`
+	GitBranch            types.String `tfsdk:"git_branch"`
+	Sensitive            types.Bool   `tfsdk:"sensitive"`
+	Comment              types.String `tfsdk:"comment"`
+}
+
+// Dotenv represents the information terraform knows about a dotenv data source
+type Dotenv struct {
+	Filename             types.String         `tfsdk:"filename"`
+	Content              types.String         `tfsdk:"content"`
+	Target               types.Set            `tfsdk:"target"`
+	CustomEnvironmentIDs types.Set            `tfsdk:"custom_environment_ids"`
+	GitBranch            types.String         `tfsdk:"git_branch"`
+	Sensitive            types.Bool           `tfsdk:"sensitive"`
+	SensitiveKeys        types.Set            `tfsdk:"sensitive_keys"`
+	NonSensitiveKeys     types.Set            `tfsdk:"non_sensitive_keys"`
+	Map                  map[string]string    `tfsdk:"map"`
+	Variables            []DotenvVariableItem `tfsdk:"variables"`
+}
+
+// Read will parse a dotenv file and provide terraform with the variables it contains.
+// It is called by the provider whenever data source values should be read to update state.
+func (d *dotenvDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config Dotenv
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content := config.Content.ValueString()
+	source := "content"
+	if !config.Filename.IsNull() {
+		source = config.Filename.ValueString()
+		b, err := os.ReadFile(config.Filename.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading dotenv file",
+				fmt.Sprintf("Could not read file %s, unexpected error: %s",
+					config.Filename.ValueString(),
+					err,
+				),
+			)
+			return
+		}
+		content = string(b)
+	}
+
+	variables, err := file.ParseDotenv(content)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing dotenv file",
+			fmt.Sprintf("Could not parse %s: %s", source, err),
+		)
+		return
+	}
+
+	var sensitiveKeys, nonSensitiveKeys []string
+	resp.Diagnostics.Append(config.SensitiveKeys.ElementsAs(ctx, &sensitiveKeys, true)...)
+	resp.Diagnostics.Append(config.NonSensitiveKeys.ElementsAs(ctx, &nonSensitiveKeys, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Map = map[string]string{}
+	config.Variables = make([]DotenvVariableItem, 0, len(variables))
+	for _, v := range variables {
+		sensitive := config.Sensitive
+		switch {
+		case matchesAnyGlob(sensitiveKeys, v.Key):
+			sensitive = types.BoolValue(true)
+		case matchesAnyGlob(nonSensitiveKeys, v.Key):
+			sensitive = types.BoolValue(false)
+		}
+
+		config.Map[v.Key] = v.Value
+		config.Variables = append(config.Variables, DotenvVariableItem{
+			Key:                  types.StringValue(v.Key),
+			Value:                types.StringValue(v.Value),
+			Target:               config.Target,
+			CustomEnvironmentIDs: config.CustomEnvironmentIDs,
+			GitBranch:            config.GitBranch,
+			Sensitive:            sensitive,
+			Comment:              types.StringNull(),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
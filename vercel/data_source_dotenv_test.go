@@ -0,0 +1,44 @@
+package vercel_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DotenvDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccDotenvDataSourceConfig),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_dotenv.test", "map.%", "3"),
+					resource.TestCheckResourceAttr("data.vercel_dotenv.test", "map.API_URL", "https://api.example.com/v1"),
+					resource.TestCheckResourceAttr("data.vercel_dotenv.test", "variables.#", "3"),
+					resource.TestCheckResourceAttr("data.vercel_dotenv.test", "variables.0.key", "API_HOST"),
+					resource.TestCheckResourceAttr("data.vercel_dotenv.test", "variables.0.sensitive", "false"),
+					resource.TestCheckResourceAttr("data.vercel_dotenv.test", "variables.0.target.#", "1"),
+					resource.TestCheckResourceAttr("data.vercel_dotenv.test", "variables.2.key", "API_SECRET"),
+					resource.TestCheckResourceAttr("data.vercel_dotenv.test", "variables.2.value", "line one\nline two"),
+					resource.TestCheckResourceAttr("data.vercel_dotenv.test", "variables.2.sensitive", "true"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDotenvDataSourceConfig = `
+data "vercel_dotenv" "test" {
+  content = <<-EOT
+    # The API to use
+    export API_HOST=api.example.com
+    API_URL="https://$${API_HOST}/v1"
+    API_SECRET="line one
+    line two"
+  EOT
+  target         = ["production"]
+  sensitive      = false
+  sensitive_keys = ["*_SECRET"]
+}
+`
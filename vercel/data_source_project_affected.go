@@ -0,0 +1,215 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/file"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &projectAffectedDataSource{}
+)
+
+func newProjectAffectedDataSource() datasource.DataSource {
+	return &projectAffectedDataSource{}
+}
+
+type projectAffectedDataSource struct{}
+
+func (d *projectAffectedDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_affected"
+}
+
+// Schema returns the schema information for a project affected data source
+func (d *projectAffectedDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Determines whether a project within a monorepo is affected by the changes between two git refs.
+
+A project is affected if any file within its root directory, or any file matching one of its dependencies, has changed. This can be used with ` + "`count`" + ` to only create a ` + "`vercel_deployment`" + ` when a project's inputs have changed.
+
+-> This data source runs ` + "`git`" + ` locally, so it requires git to be installed and both refs to be available in the local repository. Shallow clones may need to fetch the base ref first.
+`,
+		Attributes: map[string]schema.Attribute{
+			"root_directory": schema.StringAttribute{
+				Description: "The directory of the project, relative to the root of the git repository. This is the same as the project's `root_directory`. Use `.` for the repository root.",
+				Required:    true,
+			},
+			"dependencies": schema.ListAttribute{
+				Description: "A list of glob patterns, relative to the root of the git repository, for files outside the root directory that the project depends on. For example shared packages or a lockfile. The patterns use the same syntax as a `.vercelignore` file, except that every pattern is anchored to the root of the repository, so `go.mod` only matches the `go.mod` at the root. Use `**/go.mod` to match at any depth.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"base_ref": schema.StringAttribute{
+				Description: "The git ref to compare against, e.g. `origin/main` or the SHA of the last deployed commit. Changes are calculated from the merge base of `base_ref` and `head_ref`.",
+				Required:    true,
+			},
+			"head_ref": schema.StringAttribute{
+				Description: "The git ref containing the changes. Defaults to `HEAD`.",
+				Optional:    true,
+			},
+			"repository_path": schema.StringAttribute{
+				Description: "A path within the git repository. Defaults to the directory Terraform is run from.",
+				Optional:    true,
+			},
+			"affected": schema.BoolAttribute{
+				Description: "Whether any of the project's files have changed.",
+				Computed:    true,
+			},
+			"changed_files": schema.ListAttribute{
+				Description: "The changed files that affect the project, relative to the root of the git repository.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ProjectAffectedData represents the information terraform knows about a project affected data source
+type ProjectAffectedData struct {
+	RootDirectory  types.String `tfsdk:"root_directory"`
+	Dependencies   types.List   `tfsdk:"dependencies"`
+	BaseRef        types.String `tfsdk:"base_ref"`
+	HeadRef        types.String `tfsdk:"head_ref"`
+	RepositoryPath types.String `tfsdk:"repository_path"`
+	Affected       types.Bool   `tfsdk:"affected"`
+	ChangedFiles   []string     `tfsdk:"changed_files"`
+	ID             types.String `tfsdk:"id"`
+}
+
+// Read will use git to list the files changed between two refs, and filter them to the files that
+// affect the project.
+// It is called by the provider whenever data source values should be read to update state.
+func (d *projectAffectedDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ProjectAffectedData
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var dependencies []string
+	diags = config.Dependencies.ElementsAs(ctx, &dependencies, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repositoryPath := config.RepositoryPath.ValueString()
+	if repositoryPath == "" {
+		cwd, err := os.Getwd()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error determining git repository",
+				fmt.Sprintf("Could not determine the current directory, unexpected error: %s", err),
+			)
+			return
+		}
+		repositoryPath = cwd
+	}
+	headRef := config.HeadRef.ValueString()
+	if headRef == "" {
+		headRef = "HEAD"
+	}
+
+	repoRoot, err := runGit(ctx, repositoryPath, 10*time.Second, "rev-parse", "--show-toplevel")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error determining git repository",
+			fmt.Sprintf("Could not find a git repository at %s, unexpected error: %s", repositoryPath, err),
+		)
+		return
+	}
+
+	out, err := runGit(
+		ctx,
+		repoRoot,
+		time.Minute,
+		"diff", "--name-only", "--no-renames", "-z",
+		fmt.Sprintf("%s...%s", config.BaseRef.ValueString(), headRef),
+		"--",
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing changed files",
+			fmt.Sprintf("Could not list the files changed between %s and %s, unexpected error: %s", config.BaseRef.ValueString(), headRef, err),
+		)
+		return
+	}
+
+	matcher := file.NewMatcher(anchorPatterns(dependencies))
+	for _, warning := range matcher.Warnings() {
+		resp.Diagnostics.AddWarning(
+			"Invalid dependency pattern",
+			fmt.Sprintf("A dependency pattern is not valid, and has been ignored: %s", warning),
+		)
+	}
+	config.ChangedFiles = affectedFiles(
+		strings.Split(out, "\x00"),
+		config.RootDirectory.ValueString(),
+		matcher,
+	)
+	config.Affected = types.BoolValue(len(config.ChangedFiles) > 0)
+	config.ID = config.RootDirectory
+	tflog.Trace(ctx, "read project affected", map[string]any{
+		"root_directory": config.RootDirectory.ValueString(),
+		"base_ref":       config.BaseRef.ValueString(),
+		"head_ref":       headRef,
+		"changed_files":  len(config.ChangedFiles),
+	})
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// anchorPatterns anchors each pattern to the root of the repository. In a `.vercelignore` file, a pattern
+// without a slash matches at any depth, but dependencies are always relative to the repository root.
+func anchorPatterns(patterns []string) []string {
+	anchored := make([]string, 0, len(patterns))
+	for _, p := range patterns {
+		negate := strings.HasPrefix(p, "!")
+		p = strings.TrimPrefix(p, "!")
+		if p != "" && !strings.HasPrefix(p, "#") && !strings.HasPrefix(p, "/") {
+			p = "/" + strings.TrimPrefix(p, "./")
+		}
+		if negate {
+			p = "!" + p
+		}
+		anchored = append(anchored, p)
+	}
+	return anchored
+}
+
+// affectedFiles filters a list of changed files, relative to the root of a repository, down to
+// those within the root directory or matching one of the dependencies.
+func affectedFiles(changed []string, rootDirectory string, dependencies file.Matcher) []string {
+	root := path.Clean(filepath.ToSlash(rootDirectory))
+	affected := []string{}
+	for _, f := range changed {
+		if f == "" {
+			continue
+		}
+		inRoot := root == "." || f == root || strings.HasPrefix(f, root+"/")
+		if inRoot || dependencies.Matches(f) {
+			affected = append(affected, f)
+		}
+	}
+	return affected
+}
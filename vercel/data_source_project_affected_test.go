@@ -0,0 +1,103 @@
+package vercel_test
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceProjectAffected(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "vercel_project_affected" "test" {
+					root_directory = "vercel"
+					dependencies   = ["go.mod", "client/**"]
+					base_ref       = "HEAD"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_project_affected.test", "affected", "false"),
+					resource.TestCheckResourceAttr("data.vercel_project_affected.test", "changed_files.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// testAffectedRepository creates a git repository with two commits. The second commit changes a
+// shared package, a go.mod in a nested app, and the docs.
+func testAffectedRepository(t *testing.T) string {
+	dir := t.TempDir()
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %s: %s", args, err, out)
+		}
+	}
+	write := func(name, content string) {
+		t.Helper()
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	git("init", "-q")
+	for _, name := range []string{"go.mod", "apps/web/index.js", "apps/other/go.mod", "packages/ui/button.js", "docs/readme.md"} {
+		write(name, "one")
+	}
+	git("add", "-A")
+	git("commit", "-q", "-m", "one")
+	for _, name := range []string{"apps/other/go.mod", "packages/ui/button.js", "docs/readme.md"} {
+		write(name, "two")
+	}
+	git("add", "-A")
+	git("commit", "-q", "-m", "two")
+	return dir
+}
+
+func TestAcc_DataSourceProjectAffectedChanges(t *testing.T) {
+	repository := testAffectedRepository(t)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "vercel_project_affected" "hit" {
+					repository_path = "%[1]s"
+					root_directory  = "apps/web"
+					dependencies    = ["go.mod", "packages/ui/**"]
+					base_ref        = "HEAD~1"
+				}
+
+				data "vercel_project_affected" "miss" {
+					repository_path = "%[1]s"
+					root_directory  = "apps/web"
+					dependencies    = ["go.mod"]
+					base_ref        = "HEAD~1"
+				}
+				`, filepath.ToSlash(repository)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_project_affected.hit", "affected", "true"),
+					resource.TestCheckResourceAttr("data.vercel_project_affected.hit", "changed_files.#", "1"),
+					resource.TestCheckResourceAttr("data.vercel_project_affected.hit", "changed_files.0", "packages/ui/button.js"),
+					// apps/other/go.mod changed, but go.mod is anchored to the repository root.
+					resource.TestCheckResourceAttr("data.vercel_project_affected.miss", "affected", "false"),
+					resource.TestCheckResourceAttr("data.vercel_project_affected.miss", "changed_files.#", "0"),
+				),
+			},
+		},
+	})
+}
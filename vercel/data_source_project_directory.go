@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -58,17 +59,36 @@ Provides information about files within a directory on disk.
 This will recursively read files, providing metadata for use with a ` + "`vercel_deployment`." + `
 
 -> If you want to prevent files from being included, this can be done with a [vercelignore file](https://vercel.com/guides/prevent-uploading-sourcepaths-with-vercelignore).
+Ignore files are evaluated the same way as ` + "`vercel deploy`" + `: only the ` + "`.vercelignore`" + ` at the top of the directory is used, ` + "`.gitignore`" + ` files are not used, and negated patterns can re-include files that are ignored by default.
         `,
 		Attributes: map[string]schema.Attribute{
 			"path": schema.StringAttribute{
 				Description: "The path to the directory on your filesystem. Note that the path is relative to the root of the terraform files.",
 				Required:    true,
 			},
+			"include": schema.ListAttribute{
+				Description: "A list of glob patterns, using the same syntax as a `.vercelignore` file and relative to `path`. If set, only files matching at least one pattern (or inside a matching directory) are included.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"exclude": schema.ListAttribute{
+				Description: "A list of glob patterns, using the same syntax as a `.vercelignore` file and relative to `path`. Files matching any pattern are excluded, in addition to any ignore files.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"follow_symlinks": schema.BoolAttribute{
+				Description: "If true, symlinks are resolved: symlinked directories are walked and symlinked files are uploaded with the content of their target. Otherwise symlinks are uploaded as symlinks, the same as `vercel deploy`. Defaults to `false`.",
+				Optional:    true,
+			},
+			"cache_hashes": schema.BoolAttribute{
+				Description: "If true, file hashes are cached on disk within the Terraform data directory (`.terraform` by default), keyed by path, size and modification time. Files that have not changed since the last run are not read again, which speeds up plans for large directories. Defaults to `false`.",
+				Optional:    true,
+			},
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
 			"files": schema.MapAttribute{
-				Description: "A map of filename to metadata about the file. The metadata contains the file size and hash, and allows a deployment to be created if the file changes.",
+				Description: "A map of filename to metadata about the file. The metadata contains the file size and hash, and allows a deployment to be created if the file changes. The file mode is also included for executable files and symlinks.",
 				Computed:    true,
 				ElementType: types.StringType,
 			},
@@ -78,13 +98,17 @@ This will recursively read files, providing metadata for use with a ` + "`vercel
 
 // ProjectDirectoryData represents the information terraform knows about a project directory data source
 type ProjectDirectoryData struct {
-	Path  types.String      `tfsdk:"path"`
-	ID    types.String      `tfsdk:"id"`
-	Files map[string]string `tfsdk:"files"`
+	Path           types.String      `tfsdk:"path"`
+	Include        types.List        `tfsdk:"include"`
+	Exclude        types.List        `tfsdk:"exclude"`
+	FollowSymlinks types.Bool        `tfsdk:"follow_symlinks"`
+	CacheHashes    types.Bool        `tfsdk:"cache_hashes"`
+	ID             types.String      `tfsdk:"id"`
+	Files          map[string]string `tfsdk:"files"`
 }
 
-// Read will recursively scan a directory looking for any files that do not match a .vercelignore file (if a
-// .vercelignore is present). Metadata about all these files will then be made available to terraform.
+// Read will recursively scan a directory looking for any files that are not ignored by a .vercelignore file.
+// Metadata about all these files will then be made available to terraform.
 // It is called by the provider whenever data source values should be read to update state.
 func (d *projectDirectoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var config ProjectDirectoryData
@@ -94,10 +118,10 @@ func (d *projectDirectoryDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	ignoreRules, err := file.GetIgnores(config.Path.ValueString())
+	ignores, err := file.GetIgnores(config.Path.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error reading .vercelignore file",
+			"Error reading ignore file",
 			fmt.Sprintf("Could not read file, unexpected error: %s",
 				err,
 			),
@@ -105,7 +129,18 @@ func (d *projectDirectoryDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	paths, err := file.GetPaths(config.Path.ValueString(), ignoreRules)
+	opts := file.PathOptions{
+		FollowSymlinks: config.FollowSymlinks.ValueBool(),
+	}
+	diags = config.Include.ElementsAs(ctx, &opts.Include, true)
+	resp.Diagnostics.Append(diags...)
+	diags = config.Exclude.ElementsAs(ctx, &opts.Exclude, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	paths, err := file.GetPaths(config.Path.ValueString(), ignores, opts)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading directory",
@@ -117,23 +152,45 @@ func (d *projectDirectoryDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	config.Files = map[string]string{}
-	for _, path := range paths {
-		content, err := os.ReadFile(path)
+	for _, warning := range append(ignores.Warnings(), opts.Warnings()...) {
+		resp.Diagnostics.AddWarning(
+			"Invalid ignore pattern",
+			fmt.Sprintf("A pattern for directory %s is not valid, and has been ignored: %s", config.Path.ValueString(), warning),
+		)
+	}
+
+	hashOpts := file.HashOptions{
+		FollowSymlinks: opts.FollowSymlinks,
+	}
+	if config.CacheHashes.ValueBool() {
+		hashOpts.CacheFile, err = projectDirectoryCacheFile(config.Path.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
-				"Error reading file",
-				fmt.Sprintf("Could not read file %s, unexpected error: %s",
+				"Error reading directory",
+				fmt.Sprintf("Could not determine hash cache location for directory %s, unexpected error: %s",
 					config.Path.ValueString(),
 					err,
 				),
 			)
 			return
 		}
-		rawSha := sha1.Sum(content)
-		sha := hex.EncodeToString(rawSha[:])
+	}
 
-		config.Files[path] = fmt.Sprintf("%d~%s", len(content), sha)
+	hashes, err := file.HashFiles(paths, hashOpts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading file",
+			fmt.Sprintf("Could not read files for directory %s, unexpected error: %s",
+				config.Path.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	config.Files = map[string]string{}
+	for path, hash := range hashes {
+		config.Files[path] = projectFileMetadata(hash)
 	}
 
 	config.ID = config.Path
@@ -143,3 +200,28 @@ func (d *projectDirectoryDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 }
+
+// projectFileMetadata returns the `size~sha` metadata for a file within a project directory.
+// Executable files and symlinks also have their mode appended, as `size~sha~mode`, so that
+// the mode can be passed through to the deployment.
+func projectFileMetadata(hash file.FileHash) string {
+	if !hash.Symlink && hash.Mode.Perm()&0o111 == 0 {
+		return fmt.Sprintf("%d~%s", hash.Size, hash.Sha)
+	}
+	return fmt.Sprintf("%d~%s~%o", hash.Size, hash.Sha, deploymentFileMode(hash.Mode))
+}
+
+// projectDirectoryCacheFile returns the location of the hash cache for a project directory. The
+// cache is stored in the Terraform data directory, which can be overridden with TF_DATA_DIR.
+func projectDirectoryCacheFile(path string) (string, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	dataDir := os.Getenv("TF_DATA_DIR")
+	if dataDir == "" {
+		dataDir = ".terraform"
+	}
+	key := sha1.Sum([]byte(absPath))
+	return filepath.Join(dataDir, "vercel", "project_directory", hex.EncodeToString(key[:])+".json"), nil
+}
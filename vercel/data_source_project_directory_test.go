@@ -33,6 +33,26 @@ func TestAcc_DataSourceProjectDirectory(t *testing.T) {
 					),
 				),
 			},
+			{
+				Config: `
+				data "vercel_project_directory" "test" {
+					path    = "examples/one"
+					include = ["*.html", "*.png"]
+					exclude = ["*.png"]
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_project_directory.test", "files.%", "1"),
+					testChecksum("data.vercel_project_directory.test", filepath.Join("files.examples", "one", "index.html"), Checksums{
+						unix:    "60~9d3fedcc87ac72f54e75d4be7e06d0a6f8497e68",
+						windows: "65~c0b8b91602dc7a394354cd9a21460ce2070b9a13",
+					}),
+					resource.TestCheckNoResourceAttr(
+						"data.vercel_project_directory.test",
+						filepath.Join("files.examples", "one", "windows_line_ending.png"),
+					),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,208 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+var (
+	_ datasource.DataSource              = &projectRollingReleaseStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectRollingReleaseStatusDataSource{}
+)
+
+func newProjectRollingReleaseStatusDataSource() datasource.DataSource {
+	return &projectRollingReleaseStatusDataSource{}
+}
+
+type projectRollingReleaseStatusDataSource struct {
+	client *client.Client
+}
+
+func (d *projectRollingReleaseStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_rolling_release_status"
+}
+
+func (d *projectRollingReleaseStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *projectRollingReleaseStatusDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides information about the rolling release currently in progress for a Project.
+
+A rolling release gradually shifts production traffic from the current deployment to a new canary deployment, in stages. The stages are configured with the ` + "`vercel_project_rolling_release`" + ` resource, and a rolling release in progress can be advanced, completed or aborted with the ` + "`vercel_control_rolling_release`" + ` action.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/rolling-releases).
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the Project.",
+				Required:    true,
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team the Project exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether a rolling release is in progress.",
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "The state of the most recent rolling release. One of `ACTIVE`, `COMPLETE` or `ABORTED`, or null if the Project has never had a rolling release.",
+				Computed:    true,
+			},
+			"advancement_type": schema.StringAttribute{
+				Description: "How the rolling release advances between stages. Either `automatic` or `manual-approval`.",
+				Computed:    true,
+			},
+			"current_stage_index": schema.Int64Attribute{
+				Description: "The index of the current stage, starting from 0.",
+				Computed:    true,
+			},
+			"current_percentage": schema.Int64Attribute{
+				Description: "The percentage of traffic currently sent to the canary deployment.",
+				Computed:    true,
+			},
+			"next_stage_index": schema.Int64Attribute{
+				Description: "The index of the next stage, or null if the current stage is the final stage.",
+				Computed:    true,
+			},
+			"awaiting_approval": schema.BoolAttribute{
+				Description: "Whether the rolling release is waiting for the next stage to be approved.",
+				Computed:    true,
+			},
+			"canary_deployment_id": schema.StringAttribute{
+				Description: "The ID of the deployment traffic is being shifted to.",
+				Computed:    true,
+			},
+			"canary_deployment_url": schema.StringAttribute{
+				Description: "The URL of the deployment traffic is being shifted to.",
+				Computed:    true,
+			},
+			"base_deployment_id": schema.StringAttribute{
+				Description: "The ID of the deployment traffic is being shifted from.",
+				Computed:    true,
+			},
+			"base_deployment_url": schema.StringAttribute{
+				Description: "The URL of the deployment traffic is being shifted from.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ProjectRollingReleaseStatus reflects the state terraform stores internally for the status of a project's
+// rolling release.
+type ProjectRollingReleaseStatus struct {
+	ProjectID           types.String `tfsdk:"project_id"`
+	TeamID              types.String `tfsdk:"team_id"`
+	Active              types.Bool   `tfsdk:"active"`
+	State               types.String `tfsdk:"state"`
+	AdvancementType     types.String `tfsdk:"advancement_type"`
+	CurrentStageIndex   types.Int64  `tfsdk:"current_stage_index"`
+	CurrentPercentage   types.Int64  `tfsdk:"current_percentage"`
+	NextStageIndex      types.Int64  `tfsdk:"next_stage_index"`
+	AwaitingApproval    types.Bool   `tfsdk:"awaiting_approval"`
+	CanaryDeploymentID  types.String `tfsdk:"canary_deployment_id"`
+	CanaryDeploymentURL types.String `tfsdk:"canary_deployment_url"`
+	BaseDeploymentID    types.String `tfsdk:"base_deployment_id"`
+	BaseDeploymentURL   types.String `tfsdk:"base_deployment_url"`
+}
+
+func convertResponseToProjectRollingReleaseStatus(out *client.ActiveRollingRelease, projectID, teamID string) ProjectRollingReleaseStatus {
+	result := ProjectRollingReleaseStatus{
+		ProjectID:           types.StringValue(projectID),
+		TeamID:              toTeamID(teamID),
+		Active:              types.BoolValue(false),
+		State:               types.StringNull(),
+		AdvancementType:     types.StringNull(),
+		CurrentStageIndex:   types.Int64Null(),
+		CurrentPercentage:   types.Int64Null(),
+		NextStageIndex:      types.Int64Null(),
+		AwaitingApproval:    types.BoolValue(false),
+		CanaryDeploymentID:  types.StringNull(),
+		CanaryDeploymentURL: types.StringNull(),
+		BaseDeploymentID:    types.StringNull(),
+		BaseDeploymentURL:   types.StringNull(),
+	}
+	if out == nil {
+		return result
+	}
+
+	result.Active = types.BoolValue(out.State == "ACTIVE")
+	result.State = types.StringValue(out.State)
+	result.AdvancementType = types.StringValue(out.AdvancementType)
+	if out.ActiveStage != nil {
+		result.CurrentStageIndex = types.Int64Value(int64(out.ActiveStage.Index))
+		result.CurrentPercentage = types.Int64Value(int64(out.ActiveStage.TargetPercentage))
+	}
+	if out.NextStage != nil {
+		result.NextStageIndex = types.Int64Value(int64(out.NextStage.Index))
+		result.AwaitingApproval = types.BoolValue(out.State == "ACTIVE" && out.AdvancementType == "manual-approval")
+	}
+	if out.CanaryDeployment != nil {
+		result.CanaryDeploymentID = types.StringValue(out.CanaryDeployment.ID)
+		result.CanaryDeploymentURL = types.StringValue(out.CanaryDeployment.URL)
+	}
+	if out.CurrentDeployment != nil {
+		result.BaseDeploymentID = types.StringValue(out.CurrentDeployment.ID)
+		result.BaseDeploymentURL = types.StringValue(out.CurrentDeployment.URL)
+	}
+	return result
+}
+
+// Read will read the rolling release in progress for a project by requesting it from the Vercel API, and will
+// update terraform with this information.
+func (d *projectRollingReleaseStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ProjectRollingReleaseStatus
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := d.client.GetActiveRollingRelease(ctx, config.ProjectID.ValueString(), config.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading project rolling release status",
+			fmt.Sprintf("Could not get project rolling release status %s %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				config.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result := convertResponseToProjectRollingReleaseStatus(out, config.ProjectID.ValueString(), d.client.TeamID(config.TeamID.ValueString()))
+	tflog.Info(ctx, "read project rolling release status", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"state":      result.State.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,57 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ProjectRollingReleaseStatusDataSource(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.example", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccProjectRollingReleaseStatusDataSourceConfig(nameSuffix)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_project_rolling_release_status.example", "active", "false"),
+					resource.TestCheckResourceAttr("data.vercel_project_rolling_release_status.example", "awaiting_approval", "false"),
+					resource.TestCheckNoResourceAttr("data.vercel_project_rolling_release_status.example", "state"),
+					resource.TestCheckNoResourceAttr("data.vercel_project_rolling_release_status.example", "canary_deployment_id"),
+					resource.TestCheckNoResourceAttr("data.vercel_project_rolling_release_status.example", "current_percentage"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectRollingReleaseStatusDataSourceConfig(nameSuffix string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "example" {
+	name = "test-acc-rolling-release-status-%s"
+	skew_protection = "12 hours"
+}
+
+resource "vercel_project_rolling_release" "example" {
+	project_id = vercel_project.example.id
+	advancement_type = "manual-approval"
+	stages = [
+		{
+			target_percentage = 20
+		},
+		{
+			target_percentage = 100
+		}
+	]
+}
+
+data "vercel_project_rolling_release_status" "example" {
+	project_id = vercel_project_rolling_release.example.project_id
+}
+`, nameSuffix)
+}
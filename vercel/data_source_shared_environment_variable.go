@@ -33,7 +33,7 @@ func (d *sharedEnvironmentVariableDataSource) Metadata(ctx context.Context, req
 }
 
 func (d *sharedEnvironmentVariableDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
-	var config SharedEnvironmentVariable
+	var config SharedEnvironmentVariableDataSource
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -159,11 +159,39 @@ func isSameTarget(a []string, b []types.String) bool {
 	return true
 }
 
+// SharedEnvironmentVariableDataSource reflects the state terraform stores internally for a shared environment variable data source.
+type SharedEnvironmentVariableDataSource struct {
+	Target                       types.Set    `tfsdk:"target"`
+	Key                          types.String `tfsdk:"key"`
+	Value                        types.String `tfsdk:"value"`
+	TeamID                       types.String `tfsdk:"team_id"`
+	ProjectIDs                   types.Set    `tfsdk:"project_ids"`
+	ID                           types.String `tfsdk:"id"`
+	Sensitive                    types.Bool   `tfsdk:"sensitive"`
+	Comment                      types.String `tfsdk:"comment"`
+	ApplyToAllCustomEnvironments types.Bool   `tfsdk:"apply_to_all_custom_environments"`
+}
+
+func convertResponseToSharedEnvironmentVariableDataSource(response client.SharedEnvironmentVariableResponse) SharedEnvironmentVariableDataSource {
+	e := convertResponseToSharedEnvironmentVariable(response, types.StringNull())
+	return SharedEnvironmentVariableDataSource{
+		Target:                       e.Target,
+		Key:                          e.Key,
+		Value:                        e.Value,
+		TeamID:                       e.TeamID,
+		ProjectIDs:                   e.ProjectIDs,
+		ID:                           e.ID,
+		Sensitive:                    e.Sensitive,
+		Comment:                      e.Comment,
+		ApplyToAllCustomEnvironments: e.ApplyToAllCustomEnvironments,
+	}
+}
+
 // Read will read project information by requesting it from the Vercel API, and will update terraform
 // with this information.
 // It is called by the provider whenever data source values should be read to update state.
 func (d *sharedEnvironmentVariableDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var config SharedEnvironmentVariable
+	var config SharedEnvironmentVariableDataSource
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -236,7 +264,7 @@ func (d *sharedEnvironmentVariableDataSource) Read(ctx context.Context, req data
 		return
 	}
 
-	result := convertResponseToSharedEnvironmentVariable(out, types.StringNull())
+	result := convertResponseToSharedEnvironmentVariableDataSource(out)
 	tflog.Info(ctx, "read shared environment variable", map[string]any{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ID.ValueString(),
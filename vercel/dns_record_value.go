@@ -0,0 +1,114 @@
+package vercel
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var dnsHostnameRe = regexp.MustCompile(`^([a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?\.)*[a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?\.?$`)
+
+// caaTags are the property tags a CAA record can have.
+var caaTags = []string{"issue", "issuewild", "iodef", "issuemail", "issuevmc"}
+
+// caaRecord is the parsed value of a CAA record, such as `0 issue "letsencrypt.org"`.
+type caaRecord struct {
+	Flags int64
+	Tag   string
+	Value string
+}
+
+func (c caaRecord) String() string {
+	return fmt.Sprintf("%d %s %s", c.Flags, c.Tag, quoteZoneFileString(c.Value))
+}
+
+func parseCAARecord(value string) (caaRecord, error) {
+	lines, err := tokeniseZoneFile(value)
+	if err != nil || len(lines) != 1 || len(lines[0].tokens) != 3 {
+		return caaRecord{}, fmt.Errorf("expected a CAA value in the format '{flags} {tag} \"{value}\"', but got %s", value)
+	}
+	tokens := lines[0].tokens
+	flags, err := strconv.ParseInt(tokens[0].text, 10, 64)
+	if err != nil || flags < 0 || flags > 255 {
+		return caaRecord{}, fmt.Errorf("expected CAA flags to be a number between 0 and 255, but got %s", tokens[0].text)
+	}
+	tag := strings.ToLower(tokens[1].text)
+	if !contains(caaTags, tag) {
+		return caaRecord{}, fmt.Errorf("expected CAA tag to be one of %s, but got %s", strings.Join(caaTags, ", "), tokens[1].text)
+	}
+	return caaRecord{
+		Flags: flags,
+		Tag:   tag,
+		Value: tokens[2].text,
+	}, nil
+}
+
+// unquoteTXTValue returns the text of a TXT value. A value written as one or more quoted strings, such as
+// `"v=spf1 " "-all"`, is the concatenation of the strings, as long values are split into strings of at most
+// 255 characters. Any other value is the text itself.
+func unquoteTXTValue(value string) string {
+	if !strings.HasPrefix(strings.TrimSpace(value), `"`) {
+		return value
+	}
+	lines, err := tokeniseZoneFile(value)
+	if err != nil || len(lines) != 1 {
+		return value
+	}
+	var b strings.Builder
+	for _, t := range lines[0].tokens {
+		if !t.quoted {
+			return value
+		}
+		b.WriteString(t.text)
+	}
+	return b.String()
+}
+
+// validateDNSRecordValue checks that a value is valid for the type of DNS record.
+func validateDNSRecordValue(recordType, value string) error {
+	switch recordType {
+	case "A":
+		if ip := net.ParseIP(value); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("an A record value must be an IPv4 address, but got %s", value)
+		}
+	case "AAAA":
+		if ip := net.ParseIP(value); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("an AAAA record value must be an IPv6 address, but got %s", value)
+		}
+	case "ALIAS", "CNAME", "MX", "NS":
+		if len(strings.TrimSuffix(value, ".")) > 253 || !dnsHostnameRe.MatchString(value) {
+			return fmt.Errorf("a %s record value must be a hostname, but got %s", recordType, value)
+		}
+	case "CAA":
+		if _, err := parseCAARecord(value); err != nil {
+			return err
+		}
+	case "TXT":
+		if strings.HasPrefix(strings.TrimSpace(value), `"`) && unquoteTXTValue(value) == value {
+			return fmt.Errorf("a TXT record value that starts with a quote must consist of quoted strings, but got %s", value)
+		}
+	}
+	return nil
+}
+
+// normaliseDNSRecordValue returns the canonical form of a value for the type of DNS record, so that
+// equivalent values, such as hostnames with and without a trailing dot, can be compared.
+func normaliseDNSRecordValue(recordType, value string) string {
+	switch recordType {
+	case "A", "AAAA":
+		if ip := net.ParseIP(value); ip != nil {
+			return ip.String()
+		}
+	case "ALIAS", "CNAME", "MX", "NS":
+		return strings.ToLower(strings.TrimSuffix(value, "."))
+	case "CAA":
+		if caa, err := parseCAARecord(value); err == nil {
+			return caa.String()
+		}
+	case "TXT":
+		return unquoteTXTValue(value)
+	}
+	return value
+}
@@ -0,0 +1,532 @@
+package vercel
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// dnsZoneRecord is a DNS record in a form that can be compared regardless of whether it came from a zone
+// file, from terraform configuration, or from the Vercel API. Names are relative to the domain, with the
+// apex as an empty string, and hostnames have no trailing dot.
+type dnsZoneRecord struct {
+	ID         string
+	Name       string
+	Type       string
+	Value      string
+	TTL        int64
+	MXPriority int64
+	SRV        *client.SRV
+	HTTPS      *client.HTTPS
+	Comment    string
+}
+
+// dnsZoneHostValueTypes are the record types whose value is a hostname.
+var dnsZoneHostValueTypes = map[string]bool{
+	"ALIAS": true,
+	"CNAME": true,
+	"NS":    true,
+}
+
+var dnsZoneRecordTypes = []string{"A", "AAAA", "ALIAS", "CAA", "CNAME", "HTTPS", "MX", "NS", "SRV", "TXT"}
+
+// key identifies a record by everything except its TTL and comment. Records with the same key are
+// the same record, even if their TTL or comment differ.
+func (r dnsZoneRecord) key() string {
+	name := r.Name
+	if name == "" {
+		name = "@"
+	}
+	return fmt.Sprintf("%s %s %s", name, r.Type, r.rdata())
+}
+
+// rdata returns the value of the record as it would be written in a zone file, but without trailing dots.
+func (r dnsZoneRecord) rdata() string {
+	switch r.Type {
+	case "MX":
+		return fmt.Sprintf("%d %s", r.MXPriority, r.Value)
+	case "SRV":
+		if r.SRV == nil {
+			return ""
+		}
+		return fmt.Sprintf("%d %d %d %s", r.SRV.Priority, r.SRV.Weight, r.SRV.Port, r.SRV.Target)
+	case "HTTPS":
+		if r.HTTPS == nil {
+			return ""
+		}
+		return strings.TrimSpace(fmt.Sprintf("%d %s %s", r.HTTPS.Priority, r.HTTPS.Target, r.HTTPS.Params))
+	}
+	return r.Value
+}
+
+// matches reports whether the record is identical to another, treating an unset TTL as matching any TTL.
+func (r dnsZoneRecord) matches(other dnsZoneRecord) bool {
+	return r.key() == other.key() && r.Comment == other.Comment && (r.TTL == 0 || r.TTL == other.TTL)
+}
+
+func (r dnsZoneRecord) toCreateRequest(domain string) client.CreateDNSRecordRequest {
+	return client.CreateDNSRecordRequest{
+		Domain:     domain,
+		MXPriority: r.MXPriority,
+		Name:       r.Name,
+		SRV:        r.SRV,
+		HTTPS:      r.HTTPS,
+		TTL:        r.TTL,
+		Type:       r.Type,
+		Value:      r.Value,
+		Comment:    r.Comment,
+	}
+}
+
+func (r dnsZoneRecord) toUpdateRequest() client.UpdateDNSRecordRequest {
+	request := client.UpdateDNSRecordRequest{
+		Name:    &r.Name,
+		Comment: r.Comment,
+	}
+	if r.TTL != 0 {
+		request.TTL = &r.TTL
+	}
+	switch r.Type {
+	case "MX":
+		request.MXPriority = &r.MXPriority
+		request.Value = &r.Value
+	case "SRV":
+		request.SRV = &client.SRVUpdate{
+			Port:     &r.SRV.Port,
+			Priority: &r.SRV.Priority,
+			Target:   &r.SRV.Target,
+			Weight:   &r.SRV.Weight,
+		}
+	case "HTTPS":
+		request.HTTPS = r.HTTPS
+	default:
+		request.Value = &r.Value
+	}
+	return request
+}
+
+// normalise puts the value into its canonical form, so that equivalent records, such as `example.com.`
+// and `example.com`, are the same record. TXT values are already unquoted, so are left as they are.
+func (r dnsZoneRecord) normalise() dnsZoneRecord {
+	if r.Type != "TXT" && r.Type != "SRV" && r.Type != "HTTPS" {
+		r.Value = normaliseDNSRecordValue(r.Type, r.Value)
+	}
+	if r.SRV != nil {
+		srv := *r.SRV
+		srv.Target = strings.TrimSuffix(srv.Target, ".")
+		r.SRV = &srv
+	}
+	if r.HTTPS != nil {
+		https := *r.HTTPS
+		if https.Target != "." {
+			https.Target = strings.TrimSuffix(https.Target, ".")
+		}
+		https.Params = strings.Join(strings.Fields(https.Params), " ")
+		r.HTTPS = &https
+	}
+	return r
+}
+
+// dnsZoneRecordFromResponse converts a DNS record returned by the Vercel API. MX, SRV and HTTPS records are
+// returned with their additional fields as part of the value, so these are split back out.
+func dnsZoneRecordFromResponse(r client.DNSRecord) (dnsZoneRecord, error) {
+	record := dnsZoneRecord{
+		ID:      r.ID,
+		Name:    r.Name,
+		Type:    r.RecordType,
+		Value:   r.Value,
+		TTL:     r.TTL,
+		Comment: r.Comment,
+	}
+	switch r.RecordType {
+	case "MX":
+		priority, value, ok := strings.Cut(r.Value, " ")
+		p, err := strconv.ParseInt(priority, 10, 64)
+		if !ok || err != nil {
+			return record, fmt.Errorf("expected a 2 part value '{priority} {value}', but got %s", r.Value)
+		}
+		record.MXPriority = p
+		record.Value = value
+	case "SRV":
+		srv, err := parseSRVFields(strings.Fields(r.Value), true)
+		if err != nil {
+			return record, err
+		}
+		record.SRV = srv
+		record.Value = ""
+	case "HTTPS":
+		https, err := parseHTTPSFields(strings.Fields(r.Value))
+		if err != nil {
+			return record, err
+		}
+		record.HTTPS = https
+		record.Value = ""
+	}
+	return record.normalise(), nil
+}
+
+func parseSRVFields(fields []string, targetOptional bool) (*client.SRV, error) {
+	if len(fields) != 4 && !(targetOptional && len(fields) == 3) {
+		return nil, fmt.Errorf("expected a 4 part value '{priority} {weight} {port} {target}', but got %s", strings.Join(fields, " "))
+	}
+	var numbers [3]int64
+	for i, field := range []string{"priority", "weight", "port"} {
+		n, err := strconv.ParseInt(fields[i], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected SRV record %s to be an int, but got %s", field, fields[i])
+		}
+		numbers[i] = n
+	}
+	srv := &client.SRV{
+		Priority: numbers[0],
+		Weight:   numbers[1],
+		Port:     numbers[2],
+	}
+	if len(fields) == 4 {
+		srv.Target = fields[3]
+	}
+	return srv, nil
+}
+
+func parseHTTPSFields(fields []string) (*client.HTTPS, error) {
+	if len(fields) < 2 {
+		return nil, fmt.Errorf("expected a value '{priority} {target} {params}', but got %s", strings.Join(fields, " "))
+	}
+	priority, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("expected HTTPS record priority to be an int, but got %s", fields[0])
+	}
+	return &client.HTTPS{
+		Priority: priority,
+		Target:   fields[1],
+		Params:   strings.Join(fields[2:], " "),
+	}, nil
+}
+
+// sortDNSZoneRecords sorts records by name, then type, then value, so that zone files are rendered
+// consistently.
+func sortDNSZoneRecords(records []dnsZoneRecord) {
+	sort.SliceStable(records, func(i, j int) bool {
+		if records[i].Name != records[j].Name {
+			return records[i].Name < records[j].Name
+		}
+		if records[i].Type != records[j].Type {
+			return records[i].Type < records[j].Type
+		}
+		return records[i].rdata() < records[j].rdata()
+	})
+}
+
+// renderZoneFile renders records as an RFC 1035 zone file for the domain.
+func renderZoneFile(domain string, records []dnsZoneRecord) string {
+	records = append([]dnsZoneRecord{}, records...)
+	sortDNSZoneRecords(records)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s.\n", domain)
+	for _, r := range records {
+		name := r.Name
+		if name == "" {
+			name = "@"
+		}
+		var rdata string
+		switch {
+		case r.Type == "TXT":
+			rdata = quoteZoneFileString(r.Value)
+		case r.Type == "MX":
+			rdata = fmt.Sprintf("%d %s.", r.MXPriority, r.Value)
+		case r.Type == "SRV" && r.SRV != nil:
+			rdata = fmt.Sprintf("%d %d %d %s.", r.SRV.Priority, r.SRV.Weight, r.SRV.Port, r.SRV.Target)
+		case r.Type == "HTTPS" && r.HTTPS != nil:
+			target := r.HTTPS.Target
+			if target != "." {
+				target += "."
+			}
+			rdata = strings.TrimSpace(fmt.Sprintf("%d %s %s", r.HTTPS.Priority, target, r.HTTPS.Params))
+		case dnsZoneHostValueTypes[r.Type]:
+			rdata = r.Value + "."
+		default:
+			rdata = r.Value
+		}
+		line := fmt.Sprintf("%s\t%d\tIN\t%s\t%s", name, r.TTL, r.Type, rdata)
+		if r.TTL == 0 {
+			line = fmt.Sprintf("%s\tIN\t%s\t%s", name, r.Type, rdata)
+		}
+		if r.Comment != "" {
+			line += " ; " + strings.ReplaceAll(r.Comment, "\n", " ")
+		}
+		b.WriteString(line + "\n")
+	}
+	return b.String()
+}
+
+// quoteZoneFileString quotes a TXT value, splitting it into strings of at most 255 characters as
+// required by RFC 1035.
+func quoteZoneFileString(value string) string {
+	var parts []string
+	for len(value) > 255 {
+		parts = append(parts, value[:255])
+		value = value[255:]
+	}
+	parts = append(parts, value)
+	for i, p := range parts {
+		p = strings.ReplaceAll(p, `\`, `\\`)
+		parts[i] = `"` + strings.ReplaceAll(p, `"`, `\"`) + `"`
+	}
+	return strings.Join(parts, " ")
+}
+
+type zoneFileToken struct {
+	text   string
+	quoted bool
+}
+
+type zoneFileLine struct {
+	number       int
+	tokens       []zoneFileToken
+	inheritOwner bool
+	comment      string
+}
+
+// tokeniseZoneFile splits a zone file into logical lines, joining lines grouped by parentheses and
+// removing comments. The comment on a line is kept, so it can be used as the record's comment.
+func tokeniseZoneFile(content string) ([]zoneFileLine, error) {
+	var lines []zoneFileLine
+	var current *zoneFileLine
+	depth := 0
+	for i, raw := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		if current == nil {
+			current = &zoneFileLine{
+				number:       i + 1,
+				inheritOwner: len(raw) > 0 && (raw[0] == ' ' || raw[0] == '\t'),
+			}
+		}
+		for pos := 0; pos < len(raw); {
+			c := raw[pos]
+			switch {
+			case c == ' ' || c == '\t':
+				pos++
+			case c == ';':
+				current.comment = strings.TrimSpace(raw[pos+1:])
+				pos = len(raw)
+			case c == '(':
+				depth++
+				pos++
+			case c == ')':
+				if depth == 0 {
+					return nil, fmt.Errorf("line %d: unexpected )", i+1)
+				}
+				depth--
+				pos++
+			case c == '"':
+				var b strings.Builder
+				pos++
+				closed := false
+				for pos < len(raw) {
+					if raw[pos] == '\\' && pos+1 < len(raw) {
+						b.WriteByte(raw[pos+1])
+						pos += 2
+						continue
+					}
+					if raw[pos] == '"' {
+						closed = true
+						pos++
+						break
+					}
+					b.WriteByte(raw[pos])
+					pos++
+				}
+				if !closed {
+					return nil, fmt.Errorf("line %d: unterminated quoted string", i+1)
+				}
+				current.tokens = append(current.tokens, zoneFileToken{text: b.String(), quoted: true})
+			default:
+				start := pos
+				for pos < len(raw) && !strings.ContainsRune(" \t;()\"", rune(raw[pos])) {
+					pos++
+				}
+				current.tokens = append(current.tokens, zoneFileToken{text: raw[start:pos]})
+			}
+		}
+		if depth == 0 {
+			if len(current.tokens) > 0 {
+				lines = append(lines, *current)
+			}
+			current = nil
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unclosed (", current.number)
+	}
+	return lines, nil
+}
+
+func isZoneFileTTL(s string) bool {
+	_, err := strconv.ParseUint(s, 10, 32)
+	return err == nil
+}
+
+// parseZoneFile parses an RFC 1035 zone file into the records for a domain. The $ORIGIN and $TTL
+// directives are supported, and SOA records are ignored, as Vercel manages these. Names outside of the
+// domain are rejected.
+func parseZoneFile(content, domain string) ([]dnsZoneRecord, error) {
+	lines, err := tokeniseZoneFile(content)
+	if err != nil {
+		return nil, err
+	}
+
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	origin := domain
+	var defaultTTL int64
+	owner := ""
+	ownerSet := false
+	// absolute resolves a name in the zone file to a fully qualified name, without a trailing dot.
+	absolute := func(name string) string {
+		switch {
+		case name == "@":
+			return origin
+		case strings.HasSuffix(name, "."):
+			return strings.TrimSuffix(name, ".")
+		case origin == "":
+			return name
+		}
+		return name + "." + origin
+	}
+	relative := func(number int, name string) (string, error) {
+		name = strings.ToLower(absolute(name))
+		if name == domain {
+			return "", nil
+		}
+		if !strings.HasSuffix(name, "."+domain) {
+			return "", fmt.Errorf("line %d: %s is not within %s", number, name, domain)
+		}
+		return strings.TrimSuffix(name, "."+domain), nil
+	}
+
+	var records []dnsZoneRecord
+	for _, line := range lines {
+		tokens := line.tokens
+		switch strings.ToUpper(tokens[0].text) {
+		case "$ORIGIN":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: $ORIGIN requires a single domain name", line.number)
+			}
+			origin = absolute(tokens[1].text)
+			continue
+		case "$TTL":
+			if len(tokens) != 2 || !isZoneFileTTL(tokens[1].text) {
+				return nil, fmt.Errorf("line %d: $TTL requires a single number of seconds", line.number)
+			}
+			defaultTTL, _ = strconv.ParseInt(tokens[1].text, 10, 64)
+			continue
+		case "$INCLUDE", "$GENERATE":
+			return nil, fmt.Errorf("line %d: %s is not supported", line.number, tokens[0].text)
+		}
+
+		if !line.inheritOwner {
+			owner, err = relative(line.number, tokens[0].text)
+			if err != nil {
+				return nil, err
+			}
+			ownerSet = true
+			tokens = tokens[1:]
+		} else if !ownerSet {
+			return nil, fmt.Errorf("line %d: record has no name, and there is no previous record to take it from", line.number)
+		}
+
+		ttl := defaultTTL
+		recordType := ""
+		for len(tokens) > 0 && recordType == "" {
+			t := tokens[0]
+			tokens = tokens[1:]
+			switch upper := strings.ToUpper(t.text); {
+			case t.quoted:
+				return nil, fmt.Errorf("line %d: expected a record type, but got %q", line.number, t.text)
+			case isZoneFileTTL(t.text):
+				ttl, _ = strconv.ParseInt(t.text, 10, 64)
+			case upper == "IN":
+			case upper == "CH" || upper == "HS":
+				return nil, fmt.Errorf("line %d: only the IN class is supported", line.number)
+			default:
+				recordType = upper
+			}
+		}
+		if recordType == "" {
+			return nil, fmt.Errorf("line %d: missing record type", line.number)
+		}
+		if recordType == "SOA" {
+			continue
+		}
+		if !contains(dnsZoneRecordTypes, recordType) {
+			return nil, fmt.Errorf("line %d: unsupported record type %s. Supported types are %s", line.number, recordType, strings.Join(dnsZoneRecordTypes, ", "))
+		}
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: %s record has no value", line.number, recordType)
+		}
+
+		record := dnsZoneRecord{
+			Name:    owner,
+			Type:    recordType,
+			TTL:     ttl,
+			Comment: line.comment,
+		}
+		fields := make([]string, len(tokens))
+		for i, t := range tokens {
+			fields[i] = t.text
+		}
+		switch recordType {
+		case "TXT":
+			record.Value = strings.Join(fields, "")
+		case "CAA":
+			if len(tokens) != 3 {
+				return nil, fmt.Errorf("line %d: expected a CAA value '{flags} {tag} \"{value}\"'", line.number)
+			}
+			record.Value = fmt.Sprintf("%s %s %q", fields[0], fields[1], fields[2])
+		case "MX":
+			if len(fields) != 2 {
+				return nil, fmt.Errorf("line %d: expected an MX value '{priority} {host}'", line.number)
+			}
+			record.MXPriority, err = strconv.ParseInt(fields[0], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: expected MX priority to be an int, but got %s", line.number, fields[0])
+			}
+			record.Value = absolute(fields[1])
+		case "SRV":
+			record.SRV, err = parseSRVFields(fields, false)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line.number, err)
+			}
+			record.SRV.Target = absolute(record.SRV.Target)
+		case "HTTPS":
+			// A quoted parameter value, such as alpn="h2,h3", is tokenised separately from its key.
+			var params []string
+			for _, t := range tokens {
+				if t.quoted && len(params) > 0 && strings.HasSuffix(params[len(params)-1], "=") {
+					params[len(params)-1] += t.text
+					continue
+				}
+				params = append(params, t.text)
+			}
+			record.HTTPS, err = parseHTTPSFields(params)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line.number, err)
+			}
+			if record.HTTPS.Target != "." {
+				record.HTTPS.Target = absolute(record.HTTPS.Target)
+			}
+		default:
+			if len(fields) != 1 {
+				return nil, fmt.Errorf("line %d: expected a single value for a %s record, but got %s", line.number, recordType, strings.Join(fields, " "))
+			}
+			record.Value = fields[0]
+			if dnsZoneHostValueTypes[recordType] {
+				record.Value = absolute(fields[0])
+			}
+		}
+		records = append(records, record.normalise())
+	}
+	return records, nil
+}
@@ -0,0 +1,281 @@
+package vercel
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+func TestTokeniseZoneFile(t *testing.T) {
+	for _, tc := range []struct {
+		Name    string
+		Content string
+		Want    []zoneFileLine
+		Error   string
+	}{
+		{
+			Name:    "comments and blank lines",
+			Content: "; a comment\n\nwww IN A 1.1.1.1 ; the web server\n",
+			Want: []zoneFileLine{
+				{number: 3, tokens: tokens("www", "IN", "A", "1.1.1.1"), comment: "the web server"},
+			},
+		},
+		{
+			Name:    "inherited owner",
+			Content: "www IN A 1.1.1.1\n\tIN AAAA ::1\n",
+			Want: []zoneFileLine{
+				{number: 1, tokens: tokens("www", "IN", "A", "1.1.1.1")},
+				{number: 2, tokens: tokens("IN", "AAAA", "::1"), inheritOwner: true},
+			},
+		},
+		{
+			Name:    "parentheses join lines",
+			Content: "@ IN SOA ns1 hostmaster (\n  1 ; serial\n  7200 )\nwww IN A 1.1.1.1\n",
+			Want: []zoneFileLine{
+				{number: 1, tokens: tokens("@", "IN", "SOA", "ns1", "hostmaster", "1", "7200"), comment: "serial"},
+				{number: 4, tokens: tokens("www", "IN", "A", "1.1.1.1")},
+			},
+		},
+		{
+			Name:    "quoted string containing a semicolon",
+			Content: `@ IN TXT "v=spf1; -all" "\"quoted\""` + "\n",
+			Want: []zoneFileLine{
+				{number: 1, tokens: append(tokens("@", "IN", "TXT"), zoneFileToken{text: "v=spf1; -all", quoted: true}, zoneFileToken{text: `"quoted"`, quoted: true})},
+			},
+		},
+		{
+			Name:    "unterminated quoted string",
+			Content: `@ IN TXT "hello` + "\n",
+			Error:   "line 1: unterminated quoted string",
+		},
+		{
+			Name:    "unclosed parenthesis",
+			Content: "@ IN SOA ns1 hostmaster (\n  1\n",
+			Error:   "line 1: unclosed (",
+		},
+		{
+			Name:    "unexpected closing parenthesis",
+			Content: "@ IN A 1.1.1.1 )\n",
+			Error:   "line 1: unexpected )",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			got, err := tokeniseZoneFile(tc.Content)
+			if tc.Error != "" {
+				if err == nil || err.Error() != tc.Error {
+					t.Fatalf("expected error %q, but got %v", tc.Error, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(got, tc.Want) {
+				t.Errorf("expected %+v, but got %+v", tc.Want, got)
+			}
+		})
+	}
+}
+
+func TestRenderZoneFileHTTPS(t *testing.T) {
+	records := []dnsZoneRecord{
+		{Name: "", Type: "HTTPS", TTL: 60, HTTPS: &client.HTTPS{Priority: 1, Target: ".", Params: "alpn=h2,h3"}},
+		{Name: "www", Type: "HTTPS", HTTPS: &client.HTTPS{Priority: 0, Target: "app.example.com"}},
+	}
+	want := "$ORIGIN example.com.\n@\t60\tIN\tHTTPS\t1 . alpn=h2,h3\nwww\tIN\tHTTPS\t0 app.example.com.\n"
+	got := renderZoneFile("example.com", records)
+	if got != want {
+		t.Fatalf("expected %q, but got %q", want, got)
+	}
+	parsed, err := parseZoneFile(got, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(parsed, records) {
+		t.Errorf("expected the rendered zone file to parse back to %+v, but got %+v", records, parsed)
+	}
+}
+
+func tokens(texts ...string) []zoneFileToken {
+	var t []zoneFileToken
+	for _, text := range texts {
+		t = append(t, zoneFileToken{text: text})
+	}
+	return t
+}
+
+func TestParseZoneFile(t *testing.T) {
+	for _, tc := range []struct {
+		Name    string
+		Content string
+		Want    []dnsZoneRecord
+		Error   string
+	}{
+		{
+			Name: "$ORIGIN and $TTL",
+			Content: `$ORIGIN example.com.
+$TTL 300
+www IN A 1.1.1.1
+api 60 IN A 2.2.2.2
+`,
+			Want: []dnsZoneRecord{
+				{Name: "www", Type: "A", Value: "1.1.1.1", TTL: 300},
+				{Name: "api", Type: "A", Value: "2.2.2.2", TTL: 60},
+			},
+		},
+		{
+			Name: "$ORIGIN of a subdomain",
+			Content: `$ORIGIN eu.example.com.
+www IN A 1.1.1.1
+@ IN A 2.2.2.2
+`,
+			Want: []dnsZoneRecord{
+				{Name: "www.eu", Type: "A", Value: "1.1.1.1"},
+				{Name: "eu", Type: "A", Value: "2.2.2.2"},
+			},
+		},
+		{
+			Name: "inherited owner",
+			Content: `www IN A 1.1.1.1
+    IN AAAA 2001:db8::1
+`,
+			Want: []dnsZoneRecord{
+				{Name: "www", Type: "A", Value: "1.1.1.1"},
+				{Name: "www", Type: "AAAA", Value: "2001:db8::1"},
+			},
+		},
+		{
+			Name: "parentheses",
+			Content: `@ IN SOA ns1.example.com. hostmaster.example.com. (
+    2024010101 ; serial
+    7200       ; refresh
+    3600 1209600 300 )
+@ IN MX (
+    10 mail )
+`,
+			Want: []dnsZoneRecord{
+				{Name: "", Type: "MX", Value: "mail.example.com", MXPriority: 10},
+			},
+		},
+		{
+			Name: "quoted TXT containing a semicolon",
+			Content: `@ IN TXT "v=spf1 include:_spf.example.com; -all" ; spf
+_dmarc IN TXT "v=DMARC1; " "p=none"
+`,
+			Want: []dnsZoneRecord{
+				{Name: "", Type: "TXT", Value: "v=spf1 include:_spf.example.com; -all", Comment: "spf"},
+				{Name: "_dmarc", Type: "TXT", Value: "v=DMARC1; p=none"},
+			},
+		},
+		{
+			Name: "relative and absolute names",
+			Content: `www IN CNAME app
+docs.example.com. IN CNAME cname.vercel-dns.com.
+_sip._tcp IN SRV 10 5 5060 sip
+`,
+			Want: []dnsZoneRecord{
+				{Name: "www", Type: "CNAME", Value: "app.example.com"},
+				{Name: "docs", Type: "CNAME", Value: "cname.vercel-dns.com"},
+				{Name: "_sip._tcp", Type: "SRV", SRV: &client.SRV{Priority: 10, Weight: 5, Port: 5060, Target: "sip.example.com"}},
+			},
+		},
+		{
+			Name: "HTTPS records",
+			Content: `@ IN HTTPS 1 . alpn=h2,h3 ipv4hint=76.76.21.21
+www IN HTTPS 0 app
+api IN HTTPS 2 svc.example.net. alpn="h2,h3"
+`,
+			Want: []dnsZoneRecord{
+				{Name: "", Type: "HTTPS", HTTPS: &client.HTTPS{Priority: 1, Target: ".", Params: "alpn=h2,h3 ipv4hint=76.76.21.21"}},
+				{Name: "www", Type: "HTTPS", HTTPS: &client.HTTPS{Priority: 0, Target: "app.example.com"}},
+				{Name: "api", Type: "HTTPS", HTTPS: &client.HTTPS{Priority: 2, Target: "svc.example.net", Params: "alpn=h2,h3"}},
+			},
+		},
+		{
+			Name:    "HTTPS record without a target",
+			Content: "@ IN HTTPS 1\n",
+			Error:   "line 1: expected a value '{priority} {target} {params}'",
+		},
+		{
+			Name:    "names are case insensitive",
+			Content: "WWW.Example.COM. IN A 1.1.1.1\n",
+			Want: []dnsZoneRecord{
+				{Name: "www", Type: "A", Value: "1.1.1.1"},
+			},
+		},
+		{
+			Name:    "absolute name outside of the zone",
+			Content: "www.example.org. IN A 1.1.1.1\n",
+			Error:   "line 1: www.example.org is not within example.com",
+		},
+		{
+			Name:    "name that only ends with the zone",
+			Content: "badexample.com. IN A 1.1.1.1\n",
+			Error:   "line 1: badexample.com is not within example.com",
+		},
+		{
+			Name:    "$ORIGIN outside of the zone",
+			Content: "$ORIGIN example.org.\nwww IN A 1.1.1.1\n",
+			Error:   "line 2: www.example.org is not within example.com",
+		},
+		{
+			Name:    "inherited owner without a previous record",
+			Content: "  IN A 1.1.1.1\n",
+			Error:   "line 1: record has no name, and there is no previous record to take it from",
+		},
+		{
+			Name:    "unsupported record type",
+			Content: "@ IN PTR example.com.\n",
+			Error:   "line 1: unsupported record type PTR",
+		},
+		{
+			Name:    "unsupported class",
+			Content: "@ CH A 1.1.1.1\n",
+			Error:   "line 1: only the IN class is supported",
+		},
+		{
+			Name:    "$INCLUDE",
+			Content: "$INCLUDE other.zone\n",
+			Error:   "line 1: $INCLUDE is not supported",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			got, err := parseZoneFile(tc.Content, "example.com")
+			if tc.Error != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tc.Error) {
+					t.Fatalf("expected error %q, but got %v", tc.Error, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(got, tc.Want) {
+				t.Errorf("expected %+v, but got %+v", tc.Want, got)
+			}
+		})
+	}
+}
+
+func TestDNSZoneRecordFromResponseHTTPS(t *testing.T) {
+	got, err := dnsZoneRecordFromResponse(client.DNSRecord{
+		ID:         "rec_123",
+		Name:       "www",
+		RecordType: "HTTPS",
+		Value:      "1 svc.example.net. alpn=h2,h3  ipv4hint=76.76.21.21",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := dnsZoneRecord{
+		ID:    "rec_123",
+		Name:  "www",
+		Type:  "HTTPS",
+		HTTPS: &client.HTTPS{Priority: 1, Target: "svc.example.net", Params: "alpn=h2,h3 ipv4hint=76.76.21.21"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, but got %+v", want, got)
+	}
+}
@@ -0,0 +1,214 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &edgeConfigTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &edgeConfigTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &edgeConfigTokenEphemeralResource{}
+)
+
+func newEdgeConfigTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &edgeConfigTokenEphemeralResource{}
+}
+
+type edgeConfigTokenEphemeralResource struct {
+	client *client.Client
+}
+
+func (r *edgeConfigTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_edge_config_token"
+}
+
+func (r *edgeConfigTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *edgeConfigTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides an Edge Config Token, without persisting it to the Terraform plan or state.
+
+An Edge Config is a global data store that enables experimentation with feature flags, A/B testing, critical redirects, and more.
+
+An Edge Config token is used to authenticate against an Edge Config's endpoint.
+
+When a ` + "`label`" + ` is specified, a new token is created for the duration of the Terraform run, and deleted once it is no longer needed. When a ` + "`token`" + ` is specified, the existing token is read instead.
+`,
+		Attributes: map[string]schema.Attribute{
+			"edge_config_id": schema.StringAttribute{
+				Description: "The ID of the Edge Config store.",
+				Required:    true,
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team the Edge Config should exist under. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"label": schema.StringAttribute{
+				Description: "The label of the Edge Config Token. Specifying a label creates a new token that is deleted at the end of the Terraform run.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("token")),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: "A read access token used for authenticating against the Edge Config's endpoint for high volume, low-latency requests. Specifying a token reads the existing token.",
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of the Edge Config Token.",
+				Computed:    true,
+			},
+			"connection_string": schema.StringAttribute{
+				Description: "A connection string is a URL that connects a project to an Edge Config. The variable can be called anything, but our Edge Config client SDK will search for process.env.EDGE_CONFIG by default.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// edgeConfigTokenPrivateKey is the private data key used to track a token created by Open, so it
+// can be deleted by Close.
+const edgeConfigTokenPrivateKey = "edge_config_token"
+
+// Open creates a new edge config token, or reads an existing token.
+func (r *edgeConfigTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config EdgeConfigToken
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var out client.EdgeConfigToken
+	var err error
+	if config.Token.IsNull() {
+		out, err = r.client.CreateEdgeConfigToken(ctx, client.CreateEdgeConfigTokenRequest{
+			Label:        config.Label.ValueString(),
+			TeamID:       config.TeamID.ValueString(),
+			EdgeConfigID: config.EdgeConfigID.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating Edge Config Token",
+				"Could not create Edge Config Token, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		// Keep track of the created token, so it can be deleted once it is no longer needed.
+		private, err := json.Marshal(client.EdgeConfigTokenRequest{
+			TeamID:       out.TeamID,
+			EdgeConfigID: out.EdgeConfigID,
+			Token:        out.Token,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating Edge Config Token",
+				"Could not store Edge Config Token, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, edgeConfigTokenPrivateKey, private)...)
+	} else {
+		out, err = r.client.GetEdgeConfigToken(ctx, client.EdgeConfigTokenRequest{
+			Token:        config.Token.ValueString(),
+			TeamID:       config.TeamID.ValueString(),
+			EdgeConfigID: config.EdgeConfigID.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading Edge Config Token",
+				fmt.Sprintf("Could not get Edge Config Token %s %s, unexpected error: %s",
+					config.TeamID.ValueString(),
+					config.EdgeConfigID.ValueString(),
+					err,
+				),
+			)
+			return
+		}
+	}
+
+	out.EdgeConfigID = config.EdgeConfigID.ValueString()
+	out.TeamID = r.client.TeamID(config.TeamID.ValueString())
+	result := responseToEdgeConfigToken(out)
+	tflog.Info(ctx, "opened edge config token", map[string]any{
+		"team_id":        result.TeamID.ValueString(),
+		"edge_config_id": result.EdgeConfigID.ValueString(),
+		"token_id":       result.ID.ValueString(),
+	})
+
+	diags = resp.Result.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Close deletes any edge config token that was created by Open.
+func (r *edgeConfigTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, edgeConfigTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil {
+		return
+	}
+
+	var token client.EdgeConfigTokenRequest
+	err := json.Unmarshal(private, &token)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Edge Config Token",
+			"Could not read stored Edge Config Token, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.DeleteEdgeConfigToken(ctx, token)
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Edge Config Token",
+			fmt.Sprintf(
+				"Could not delete Edge Config Token %s, unexpected error: %s",
+				token.EdgeConfigID,
+				err,
+			),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "deleted edge config token", map[string]any{
+		"team_id":        token.TeamID,
+		"edge_config_id": token.EdgeConfigID,
+	})
+}
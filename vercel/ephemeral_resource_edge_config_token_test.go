@@ -0,0 +1,75 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_EdgeConfigTokenEphemeralResource(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccEdgeConfigTokenEphemeralResourceConfig(name)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.created", "data.label", "test-acc-ephemeral-token"),
+					resource.TestCheckResourceAttrSet("echo.created", "data.id"),
+					resource.TestCheckResourceAttrSet("echo.created", "data.token"),
+					resource.TestCheckResourceAttrSet("echo.created", "data.connection_string"),
+					resource.TestCheckResourceAttr("echo.existing", "data.label", "test-acc-token"),
+					resource.TestCheckResourceAttrPair("echo.existing", "data.id", "vercel_edge_config_token.test", "id"),
+					resource.TestCheckResourceAttrPair("echo.existing", "data.connection_string", "vercel_edge_config_token.test", "connection_string"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEdgeConfigTokenEphemeralResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "vercel_edge_config" "test" {
+    name = "%[1]s"
+}
+
+resource "vercel_edge_config_token" "test" {
+    label          = "test-acc-token"
+    edge_config_id = vercel_edge_config.test.id
+}
+
+ephemeral "vercel_edge_config_token" "created" {
+    label          = "test-acc-ephemeral-token"
+    edge_config_id = vercel_edge_config.test.id
+}
+
+ephemeral "vercel_edge_config_token" "existing" {
+    edge_config_id = vercel_edge_config.test.id
+    token          = vercel_edge_config_token.test.token
+}
+
+provider "echo" {
+    alias = "created"
+    data  = ephemeral.vercel_edge_config_token.created
+}
+
+provider "echo" {
+    alias = "existing"
+    data  = ephemeral.vercel_edge_config_token.existing
+}
+
+resource "echo" "created" {
+    provider = echo.created
+}
+
+resource "echo" "existing" {
+    provider = echo.existing
+}
+`, name)
+}
@@ -0,0 +1,172 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &environmentVariableEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &environmentVariableEphemeralResource{}
+)
+
+func newEnvironmentVariableEphemeralResource() ephemeral.EphemeralResource {
+	return &environmentVariableEphemeralResource{}
+}
+
+type environmentVariableEphemeralResource struct {
+	client *client.Client
+}
+
+func (r *environmentVariableEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_variable"
+}
+
+func (r *environmentVariableEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *environmentVariableEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides the decrypted value of an existing Project Environment Variable.
+
+As an ephemeral resource, the value is never persisted to the Terraform plan or state. This allows it to be passed to other providers, or to write-only attributes, without being stored.
+
+Sensitive Environment Variables cannot be decrypted, so cannot be read.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Environment Variable.",
+			},
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Vercel project.",
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the Vercel team. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"key": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the Environment Variable.",
+			},
+			"value": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The decrypted value of the Environment Variable.",
+			},
+			"target": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The environments that the Environment Variable is applied to.",
+			},
+			"custom_environment_ids": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of Custom Environments that the Environment Variable is applied to.",
+			},
+			"git_branch": schema.StringAttribute{
+				Computed:    true,
+				Description: "The git branch of the Environment Variable.",
+			},
+			"comment": schema.StringAttribute{
+				Computed:    true,
+				Description: "A comment explaining what the environment variable is for.",
+			},
+		},
+	}
+}
+
+type EnvironmentVariableEphemeral struct {
+	ID                   types.String `tfsdk:"id"`
+	ProjectID            types.String `tfsdk:"project_id"`
+	TeamID               types.String `tfsdk:"team_id"`
+	Key                  types.String `tfsdk:"key"`
+	Value                types.String `tfsdk:"value"`
+	Target               types.Set    `tfsdk:"target"`
+	CustomEnvironmentIDs types.Set    `tfsdk:"custom_environment_ids"`
+	GitBranch            types.String `tfsdk:"git_branch"`
+	Comment              types.String `tfsdk:"comment"`
+}
+
+// Open reads the decrypted environment variable from the Vercel API.
+func (r *environmentVariableEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config EnvironmentVariableEphemeral
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetEnvironmentVariable(ctx, config.ProjectID.ValueString(), config.TeamID.ValueString(), config.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading project environment variable",
+			fmt.Sprintf("Could not get project environment variable %s %s %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				config.ProjectID.ValueString(),
+				config.ID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+	if out.Type == "sensitive" {
+		resp.Diagnostics.AddError(
+			"Error reading project environment variable",
+			fmt.Sprintf("The project environment variable %s is sensitive, so its value cannot be read", out.Key),
+		)
+		return
+	}
+
+	target, diags := types.SetValueFrom(ctx, types.StringType, out.Target)
+	resp.Diagnostics.Append(diags...)
+	customEnvironmentIDs, diags := types.SetValueFrom(ctx, types.StringType, out.CustomEnvironmentIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result := EnvironmentVariableEphemeral{
+		ID:                   types.StringValue(out.ID),
+		ProjectID:            config.ProjectID,
+		TeamID:               toTeamID(r.client.TeamID(config.TeamID.ValueString())),
+		Key:                  types.StringValue(out.Key),
+		Value:                types.StringValue(out.Value),
+		Target:               target,
+		CustomEnvironmentIDs: customEnvironmentIDs,
+		GitBranch:            types.StringPointerValue(out.GitBranch),
+		Comment:              types.StringValue(out.Comment),
+	}
+	tflog.Info(ctx, "read project environment variable", map[string]any{
+		"id":         result.ID.ValueString(),
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+	})
+
+	diags = resp.Result.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
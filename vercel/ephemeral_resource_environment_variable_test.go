@@ -0,0 +1,60 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_EnvironmentVariableEphemeralResource(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccEnvironmentVariableEphemeralResourceConfig(nameSuffix)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.test", "data.key", "foo"),
+					resource.TestCheckResourceAttr("echo.test", "data.value", "bar"),
+					resource.TestCheckResourceAttr("echo.test", "data.target.#", "1"),
+					resource.TestCheckTypeSetElemAttr("echo.test", "data.target.*", "production"),
+					resource.TestCheckResourceAttr("echo.test", "data.comment", "a comment"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnvironmentVariableEphemeralResourceConfig(projectName string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "example" {
+  name = "test-acc-example-project-%[1]s"
+}
+
+resource "vercel_project_environment_variable" "example" {
+  project_id = vercel_project.example.id
+  key        = "foo"
+  value      = "bar"
+  target     = ["production"]
+  sensitive  = false
+  comment    = "a comment"
+}
+
+ephemeral "vercel_environment_variable" "example" {
+  project_id = vercel_project.example.id
+  id         = vercel_project_environment_variable.example.id
+}
+
+provider "echo" {
+  data = ephemeral.vercel_environment_variable.example
+}
+
+resource "echo" "test" {}
+`, projectName)
+}
@@ -0,0 +1,162 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &projectProtectionBypassEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &projectProtectionBypassEphemeralResource{}
+)
+
+func newProjectProtectionBypassEphemeralResource() ephemeral.EphemeralResource {
+	return &projectProtectionBypassEphemeralResource{}
+}
+
+type projectProtectionBypassEphemeralResource struct {
+	client *client.Client
+}
+
+func (r *projectProtectionBypassEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_protection_bypass"
+}
+
+func (r *projectProtectionBypassEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *projectProtectionBypassEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides the Protection Bypass for Automation secret of an existing Project, without persisting it to the Terraform plan or state.
+
+The secret can be used to bypass Deployment Protection when running automated tooling, such as end-to-end tests, against a deployment.
+
+Protection Bypass for Automation must already be enabled for the project, for example with the ` + "`protection_bypass_for_automation`" + ` field of the ` + "`vercel_project`" + ` resource. If the project has more than one secret, such as those created with the ` + "`vercel_project_protection_bypass`" + ` resource, the ` + "`note`" + ` of the secret must be set to select one of them.
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Vercel project.",
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team the project exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"note": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The note of the secret to read. Required if the project has more than one Protection Bypass for Automation secret.",
+			},
+			"secret": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secret used to bypass Deployment Protection. This should be sent in the `x-vercel-protection-bypass` header.",
+			},
+		},
+	}
+}
+
+type ProjectProtectionBypassEphemeral struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	Note      types.String `tfsdk:"note"`
+	Secret    types.String `tfsdk:"secret"`
+}
+
+// selectProtectionBypassSecret returns the Protection Bypass for Automation secret with the note, or the only
+// secret if the note is null. It is an error for there to be no matching secret, or more than one.
+func selectProtectionBypassSecret(bypasses map[string]client.ProtectionBypass, note types.String) (string, error) {
+	// The secrets are the keys of the map. Sort them so any error is stable.
+	var matching []string
+	for _, k := range slices.Sorted(maps.Keys(bypasses)) {
+		if bypasses[k].Scope != "automation-bypass" {
+			continue
+		}
+		if note.IsNull() || bypasses[k].Note == note.ValueString() {
+			matching = append(matching, k)
+		}
+	}
+	switch {
+	case len(matching) == 1:
+		return matching[0], nil
+	case len(matching) == 0 && note.IsNull():
+		return "", fmt.Errorf("protection bypass for automation is not enabled")
+	case len(matching) == 0:
+		return "", fmt.Errorf("the project has no Protection Bypass for Automation secret with the note %q", note.ValueString())
+	case note.IsNull():
+		return "", fmt.Errorf("the project has %d Protection Bypass for Automation secrets, so `note` must be set to select one of them", len(matching))
+	default:
+		return "", fmt.Errorf("the project has %d Protection Bypass for Automation secrets with the note %q, so one cannot be selected", len(matching), note.ValueString())
+	}
+}
+
+// Open reads the project's protection bypass for automation secret from the Vercel API.
+func (r *projectProtectionBypassEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config ProjectProtectionBypassEphemeral
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetProject(ctx, config.ProjectID.ValueString(), config.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading project protection bypass",
+			fmt.Sprintf("Could not read project %s %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				config.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	secret, err := selectProtectionBypassSecret(out.ProtectionBypass, config.Note)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading project protection bypass",
+			fmt.Sprintf("Could not read the protection bypass of project %s, %s", config.ProjectID.ValueString(), err),
+		)
+		return
+	}
+
+	result := ProjectProtectionBypassEphemeral{
+		ProjectID: config.ProjectID,
+		TeamID:    toTeamID(out.TeamID),
+		Note:      types.StringValue(out.ProtectionBypass[secret].Note),
+		Secret:    types.StringValue(secret),
+	}
+	tflog.Info(ctx, "read project protection bypass", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+	})
+
+	diags = resp.Result.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
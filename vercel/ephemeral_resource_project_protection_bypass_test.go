@@ -0,0 +1,93 @@
+package vercel_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ProjectProtectionBypassEphemeralResource(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccProjectProtectionBypassEphemeralResourceConfig(nameSuffix)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.test", "data.secret", "12345678912345678912345678912345"),
+				),
+			},
+			{
+				Config: cfg(testAccProjectProtectionBypassEphemeralResourceConfigMultiple(nameSuffix, `note = "ci"`)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.test", "data.secret", "abcdefghijabcdefghijabcdefghij12"),
+					resource.TestCheckResourceAttr("echo.test", "data.note", "ci"),
+				),
+			},
+			{
+				Config:      cfg(testAccProjectProtectionBypassEphemeralResourceConfigMultiple(nameSuffix, "")),
+				ExpectError: regexp.MustCompile(`has\s+2\s+Protection\s+Bypass\s+for\s+Automation\s+secrets,\s+so\s+.note.\s+must\s+be\s+set`),
+			},
+			{
+				Config:      cfg(testAccProjectProtectionBypassEphemeralResourceConfigMultiple(nameSuffix, `note = "missing"`)),
+				ExpectError: regexp.MustCompile(`no\s+Protection\s+Bypass\s+for\s+Automation\s+secret\s+with\s+the\s+note\s+"missing"`),
+			},
+		},
+	})
+}
+
+func testAccProjectProtectionBypassEphemeralResourceConfig(projectName string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "example" {
+  name                                    = "test-acc-example-project-%[1]s"
+  protection_bypass_for_automation        = true
+  protection_bypass_for_automation_secret = "12345678912345678912345678912345"
+}
+
+ephemeral "vercel_project_protection_bypass" "example" {
+  project_id = vercel_project.example.id
+}
+
+provider "echo" {
+  data = ephemeral.vercel_project_protection_bypass.example
+}
+
+resource "echo" "test" {}
+`, projectName)
+}
+
+func testAccProjectProtectionBypassEphemeralResourceConfigMultiple(projectName, note string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "example" {
+  name                                    = "test-acc-example-project-%[1]s"
+  protection_bypass_for_automation        = true
+  protection_bypass_for_automation_secret = "12345678912345678912345678912345"
+}
+
+resource "vercel_project_protection_bypass" "ci" {
+  project_id = vercel_project.example.id
+  secret     = "abcdefghijabcdefghijabcdefghij12"
+  note       = "ci"
+}
+
+ephemeral "vercel_project_protection_bypass" "example" {
+  project_id = vercel_project.example.id
+  %[2]s
+
+  depends_on = [vercel_project_protection_bypass.ci]
+}
+
+provider "echo" {
+  data = ephemeral.vercel_project_protection_bypass.example
+}
+
+resource "echo" "test" {}
+`, projectName, note)
+}
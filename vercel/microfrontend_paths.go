@@ -0,0 +1,67 @@
+package vercel
+
+import "strings"
+
+// microfrontendPathSegments splits a path into its segments, ignoring any leading or trailing slashes.
+func microfrontendPathSegments(p string) []string {
+	p = strings.Trim(p, "/")
+	if p == "" {
+		return nil
+	}
+	return strings.Split(p, "/")
+}
+
+// microfrontendPathsOverlap reports whether any request path could be matched by both paths. Paths use the
+// path-to-regexp syntax of microfrontends.json: `:name` matches a single segment, and a final `:name*` or
+// `:name+` matches any number, or at least one, of the remaining segments.
+func microfrontendPathsOverlap(a, b string) bool {
+	return microfrontendSegmentsOverlap(microfrontendPathSegments(a), microfrontendPathSegments(b))
+}
+
+func microfrontendSegmentsOverlap(a, b []string) bool {
+	if len(a) > 0 && strings.HasPrefix(a[0], ":") && strings.HasSuffix(a[0], "*") {
+		return true
+	}
+	if len(b) > 0 && strings.HasPrefix(b[0], ":") && strings.HasSuffix(b[0], "*") {
+		return true
+	}
+	if len(a) > 0 && strings.HasPrefix(a[0], ":") && strings.HasSuffix(a[0], "+") {
+		return len(b) > 0
+	}
+	if len(b) > 0 && strings.HasPrefix(b[0], ":") && strings.HasSuffix(b[0], "+") {
+		return len(a) > 0
+	}
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+	if !strings.HasPrefix(a[0], ":") && !strings.HasPrefix(b[0], ":") && a[0] != b[0] {
+		return false
+	}
+	return microfrontendSegmentsOverlap(a[1:], b[1:])
+}
+
+// microfrontendPathOverlap identifies a path of one application that overlaps with a path of an earlier
+// application, by their indexes.
+type microfrontendPathOverlap struct {
+	app, path           int
+	otherApp, otherPath int
+}
+
+// overlappingMicrofrontendPaths compares the paths of each application with the paths of every other
+// application. Paths of the same application may overlap, such as `/docs` and `/docs/:path*`, as they
+// are all routed to the same place.
+func overlappingMicrofrontendPaths(paths [][]string) []microfrontendPathOverlap {
+	var overlaps []microfrontendPathOverlap
+	for i := range paths {
+		for pi, p := range paths[i] {
+			for j := 0; j < i; j++ {
+				for pj, otherPath := range paths[j] {
+					if microfrontendPathsOverlap(p, otherPath) {
+						overlaps = append(overlaps, microfrontendPathOverlap{app: i, path: pi, otherApp: j, otherPath: pj})
+					}
+				}
+			}
+		}
+	}
+	return overlaps
+}
@@ -0,0 +1,68 @@
+package vercel
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMicrofrontendPathsOverlap(t *testing.T) {
+	for _, tc := range []struct {
+		A, B string
+		Want bool
+	}{
+		{A: "/docs", B: "/docs", Want: true},
+		{A: "/docs", B: "/docs/", Want: true},
+		{A: "/docs", B: "/blog", Want: false},
+		{A: "/docs", B: "/docs/intro", Want: false},
+		{A: "/docs/:slug", B: "/docs/intro", Want: true},
+		{A: "/docs/:slug", B: "/docs", Want: false},
+		{A: "/docs/:slug", B: "/docs/a/b", Want: false},
+		{A: "/docs/:path*", B: "/docs", Want: true},
+		{A: "/docs/:path*", B: "/docs/a/b", Want: true},
+		{A: "/docs/:path+", B: "/docs", Want: false},
+		{A: "/docs/:path+", B: "/docs/a/b", Want: true},
+		{A: "/:path*", B: "/blog", Want: true},
+		{A: "/:lang/docs", B: "/en/blog", Want: false},
+		{A: "/:lang/docs", B: "/en/docs", Want: true},
+	} {
+		t.Run(tc.A+" "+tc.B, func(t *testing.T) {
+			if got := microfrontendPathsOverlap(tc.A, tc.B); got != tc.Want {
+				t.Errorf("expected %t, but got %t", tc.Want, got)
+			}
+			if got := microfrontendPathsOverlap(tc.B, tc.A); got != tc.Want {
+				t.Errorf("expected %t with the paths reversed, but got %t", tc.Want, got)
+			}
+		})
+	}
+}
+
+func TestOverlappingMicrofrontendPaths(t *testing.T) {
+	for _, tc := range []struct {
+		Name  string
+		Paths [][]string
+		Want  []microfrontendPathOverlap
+	}{
+		{
+			Name:  "paths of the same application may overlap",
+			Paths: [][]string{{"/docs", "/docs/:path*"}},
+		},
+		{
+			Name:  "separate paths",
+			Paths: [][]string{{"/docs", "/docs/:path*"}, {"/blog", "/blog/:path*"}},
+		},
+		{
+			Name:  "paths of different applications",
+			Paths: [][]string{{"/docs", "/docs/:path*"}, {"/blog"}, {"/docs/:slug"}},
+			Want: []microfrontendPathOverlap{
+				{app: 2, path: 0, otherApp: 0, otherPath: 1},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			got := overlappingMicrofrontendPaths(tc.Paths)
+			if !reflect.DeepEqual(got, tc.Want) {
+				t.Errorf("expected %+v, but got %+v", tc.Want, got)
+			}
+		})
+	}
+}
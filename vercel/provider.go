@@ -6,7 +6,9 @@ import (
 	"os"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,6 +19,11 @@ import (
 
 type vercelProvider struct{}
 
+var (
+	_ provider.ProviderWithEphemeralResources = &vercelProvider{}
+	_ provider.ProviderWithActions            = &vercelProvider{}
+)
+
 // New instantiates a new instance of a vercel terraform provider.
 func New() provider.Provider {
 	return &vercelProvider{}
@@ -59,6 +66,8 @@ func (p *vercelProvider) Resources(_ context.Context) []func() resource.Resource
 		newCustomEnvironmentResource,
 		newDeploymentResource,
 		newDNSRecordResource,
+		newDNSZoneResource,
+		newDomainResource,
 		newEdgeConfigItemResource,
 		newEdgeConfigResource,
 		newEdgeConfigSchemaResource,
@@ -69,12 +78,17 @@ func (p *vercelProvider) Resources(_ context.Context) []func() resource.Resource
 		newLogDrainResource,
 		newMicrofrontendGroupMembershipResource,
 		newMicrofrontendGroupResource,
+		newMicrofrontendRoutingResource,
 		newProjectDeploymentRetentionResource,
+		newProjectCronJobResource,
 		newProjectCronsResource,
+		newProjectDeployHookResource,
 		newProjectDomainResource,
+		newProjectDomainVerificationResource,
 		newProjectEnvironmentVariableResource,
 		newProjectEnvironmentVariablesResource,
 		newProjectMembersResource,
+		newProjectProtectionBypassResource,
 		newProjectResource,
 		newSharedEnvironmentVariableProjectLinkResource,
 		newSharedEnvironmentVariableResource,
@@ -91,9 +105,14 @@ func (p *vercelProvider) DataSources(_ context.Context) []func() datasource.Data
 		newAccessGroupProjectDataSource,
 		newAliasDataSource,
 		newAttackChallengeModeDataSource,
+		newCertificatesDataSource,
 		newCustomEnvironmentDataSource,
+		newCustomEnvironmentsDataSource,
 		newDeploymentDataSource,
+		newDNSZoneDataSource,
 		newDomainConfigDataSource,
+		newDomainDataSource,
+		newDotenvDataSource,
 		newEdgeConfigDataSource,
 		newEdgeConfigItemDataSource,
 		newEdgeConfigSchemaDataSource,
@@ -102,6 +121,7 @@ func (p *vercelProvider) DataSources(_ context.Context) []func() datasource.Data
 		newFileDataSource,
 		newLogDrainDataSource,
 		newPrebuiltProjectDataSource,
+		newProjectAffectedDataSource,
 		newProjectDataSource,
 		newProjectDeploymentRetentionDataSource,
 		newProjectDirectoryDataSource,
@@ -112,10 +132,26 @@ func (p *vercelProvider) DataSources(_ context.Context) []func() datasource.Data
 		newMicrofrontendGroupDataSource,
 		newMicrofrontendGroupMembershipDataSource,
 		newProjectRollingReleaseDataSource,
+		newProjectRollingReleaseStatusDataSource,
 		newDsyncGroupsDataSource,
 	}
 }
 
+func (p *vercelProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newEdgeConfigTokenEphemeralResource,
+		newEnvironmentVariableEphemeralResource,
+		newProjectProtectionBypassEphemeralResource,
+	}
+}
+
+func (p *vercelProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		newControlRollingReleaseAction,
+		newTriggerDeployHookAction,
+	}
+}
+
 type providerData struct {
 	APIToken types.String `tfsdk:"api_token"`
 	Team     types.String `tfsdk:"team"`
@@ -189,4 +225,6 @@ func (p *vercelProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	resp.DataSourceData = vercelClient
 	resp.ResourceData = vercelClient
+	resp.EphemeralResourceData = vercelClient
+	resp.ActionData = vercelClient
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/vercel/terraform-provider-vercel/v3/client"
 	"github.com/vercel/terraform-provider-vercel/v3/vercel"
 )
@@ -15,6 +16,13 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"vercel": providerserver.NewProtocol6WithError(vercel.New()),
 }
 
+// testAccProtoV6ProviderFactoriesWithEcho includes the echo provider, which exposes ephemeral
+// values as the `data` attribute of an `echo` resource so they can be checked.
+var testAccProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
+	"vercel": providerserver.NewProtocol6WithError(vercel.New()),
+	"echo":   echoprovider.NewProviderServer(),
+}
+
 var tc *client.Client
 
 func testClient(t *testing.T) *client.Client {
@@ -81,6 +89,16 @@ func testDomain(t *testing.T) string {
 	return value
 }
 
+// testExclusiveDomain returns a domain whose DNS records are all managed by the test, as an exclusive
+// vercel_dns_zone deletes every record it does not configure. The test is skipped if it is not set.
+func testExclusiveDomain(t *testing.T) string {
+	value := os.Getenv("VERCEL_TERRAFORM_TESTING_EXCLUSIVE_DOMAIN")
+	if value == "" {
+		t.Skip("Skipping test as VERCEL_TERRAFORM_TESTING_EXCLUSIVE_DOMAIN is not set")
+	}
+	return value
+}
+
 func testAdditionalUserEmail(t *testing.T) string {
 	value := os.Getenv("VERCEL_TERRAFORM_TESTING_ADDITIONAL_USER_EMAIL")
 	if value == "" {
@@ -2,12 +2,20 @@ package vercel
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/vercel/terraform-provider-vercel/v3/client"
@@ -15,8 +23,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &customCertificateResource{}
-	_ resource.ResourceWithConfigure = &customCertificateResource{}
+	_ resource.Resource                   = &customCertificateResource{}
+	_ resource.ResourceWithConfigure      = &customCertificateResource{}
+	_ resource.ResourceWithImportState    = &customCertificateResource{}
+	_ resource.ResourceWithModifyPlan     = &customCertificateResource{}
+	_ resource.ResourceWithValidateConfig = &customCertificateResource{}
 )
 
 func newCustomCertificateResource() resource.Resource {
@@ -56,6 +67,10 @@ Provides a Custom Certificate Resource, allowing Custom Certificates to be uploa
 
 By default, Vercel provides all domains with a custom SSL certificates. However, Enterprise teams can upload their own custom SSL certificate.
 
+The certificate is parsed when planning, so that its expiry, issuer and domains are known before it is uploaded. The plan fails if the private key does not match the certificate, or if the certificate cannot be verified against the ` + "`certificate_authority_certificate`" + `, and warns when the certificate is close to expiry.
+
+A Custom Certificate cannot be changed once uploaded, so changing any of the PEM attributes replaces it. To make sure the new certificate is uploaded before the old one is removed when rotating certificates, set ` + "`create_before_destroy`" + ` in the resource's ` + "`lifecycle`" + ` block.
+
 For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/domains/custom-SSL-certificate).
 `,
 		Attributes: map[string]schema.Attribute{
@@ -73,28 +88,239 @@ For more detailed information, please see the [Vercel documentation](https://ver
 			"private_key": schema.StringAttribute{
 				Description:   "The private key of the Certificate. Should be in PEM format.",
 				Required:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{requiresReplaceUnlessImported()},
 			},
 			"certificate": schema.StringAttribute{
 				Description:   "The certificate itself. Should be in PEM format.",
 				Required:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{requiresReplaceUnlessImported()},
 			},
 			"certificate_authority_certificate": schema.StringAttribute{
 				Description:   "The Certificate Authority root certificate such as one of Let's Encrypt's ISRG root certificates. This will be provided by your certificate issuer and is different to the core certificate. This may be included in their download process or available for download on their website. Should be in PEM format.",
 				Required:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{requiresReplaceUnlessImported()},
+			},
+			"expiry_warning_days": schema.Int64Attribute{
+				Description: "A warning is shown when planning if the certificate expires within this many days. Set to `0` to disable the warning. Defaults to `30`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(30),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"not_before": schema.StringAttribute{
+				Description: "The time the certificate becomes valid, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"not_after": schema.StringAttribute{
+				Description: "The time the certificate expires, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"issuer": schema.StringAttribute{
+				Description: "The distinguished name of the issuer of the certificate.",
+				Computed:    true,
+			},
+			"subject_alternative_names": schema.ListAttribute{
+				Description: "The domain names and IP addresses the certificate is valid for.",
+				Computed:    true,
+				ElementType: types.StringType,
 			},
 		},
 	}
 }
 
+// requiresReplaceUnlessImported replaces the certificate when a PEM attribute changes. Imported certificates
+// have no PEM attributes in state, as the API does not return them, so the configured values are adopted
+// without replacing the certificate.
+func requiresReplaceUnlessImported() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		"Changing the value requires the Custom Certificate to be replaced, unless it was imported.",
+		"Changing the value requires the Custom Certificate to be replaced, unless it was imported.",
+	)
+}
+
 type CustomCertificate struct {
 	ID                              types.String `tfsdk:"id"`
 	TeamID                          types.String `tfsdk:"team_id"`
 	PrivateKey                      types.String `tfsdk:"private_key"`
 	Certificate                     types.String `tfsdk:"certificate"`
 	CertificateAuthorityCertificate types.String `tfsdk:"certificate_authority_certificate"`
+	ExpiryWarningDays               types.Int64  `tfsdk:"expiry_warning_days"`
+	NotBefore                       types.String `tfsdk:"not_before"`
+	NotAfter                        types.String `tfsdk:"not_after"`
+	Issuer                          types.String `tfsdk:"issuer"`
+	SubjectAlternativeNames         types.List   `tfsdk:"subject_alternative_names"`
+}
+
+// parseCertificates parses every certificate in a PEM string, in the order they appear.
+func parseCertificates(value string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := []byte(value)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+	return certs, nil
+}
+
+// verifyCertificateChain checks that the certificate, along with any intermediate certificates that
+// follow it, can be verified against the certificate authority. The chain is verified at the time the
+// certificate becomes valid, so that an expired certificate still has a complete chain.
+func verifyCertificateChain(certs, authority []*x509.Certificate) error {
+	roots := x509.NewCertPool()
+	for _, c := range authority {
+		roots.AddCert(c)
+	}
+	intermediates := x509.NewCertPool()
+	for _, c := range certs[1:] {
+		intermediates.AddCert(c)
+	}
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   certs[0].NotBefore,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	return err
+}
+
+// setCertificateDetails sets the computed attributes from the certificate.
+func (c *CustomCertificate) setCertificateDetails(cert *x509.Certificate) {
+	names := append([]string{}, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		names = append(names, ip.String())
+	}
+	c.NotBefore = types.StringValue(cert.NotBefore.UTC().Format(time.RFC3339))
+	c.NotAfter = types.StringValue(cert.NotAfter.UTC().Format(time.RFC3339))
+	c.Issuer = types.StringValue(cert.Issuer.String())
+	c.SubjectAlternativeNames = toStringList(names)
+}
+
+// setResponseDetails sets the computed attributes from the API, for imported certificates that have no
+// PEM attributes to parse. The API does not return the issuer, or when the certificate becomes valid.
+func (c *CustomCertificate) setResponseDetails(out client.CertificateResponse) {
+	c.NotBefore = types.StringNull()
+	c.NotAfter = types.StringNull()
+	if out.ExpiresAt != 0 {
+		c.NotAfter = types.StringValue(time.UnixMilli(out.ExpiresAt).UTC().Format(time.RFC3339))
+	}
+	c.Issuer = types.StringNull()
+	c.SubjectAlternativeNames = toStringList(out.CNs)
+}
+
+// ValidateConfig checks the private key matches the certificate, that the certificate chain is complete,
+// and warns if the certificate is close to expiry.
+func (r *customCertificateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config CustomCertificate
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.Certificate.IsNull() || config.Certificate.IsUnknown() {
+		return
+	}
+
+	certs, err := parseCertificates(config.Certificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("certificate"),
+			"Invalid Custom Certificate",
+			"Could not parse the certificate: "+err.Error(),
+		)
+		return
+	}
+
+	if !config.PrivateKey.IsNull() && !config.PrivateKey.IsUnknown() {
+		_, err := tls.X509KeyPair([]byte(config.Certificate.ValueString()), []byte(config.PrivateKey.ValueString()))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("private_key"),
+				"Invalid Custom Certificate",
+				"The private key is not valid for the certificate: "+err.Error(),
+			)
+		}
+	}
+
+	if !config.CertificateAuthorityCertificate.IsNull() && !config.CertificateAuthorityCertificate.IsUnknown() {
+		authority, err := parseCertificates(config.CertificateAuthorityCertificate.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("certificate_authority_certificate"),
+				"Invalid Custom Certificate",
+				"Could not parse the certificate authority certificate: "+err.Error(),
+			)
+		} else if err := verifyCertificateChain(certs, authority); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("certificate_authority_certificate"),
+				"Invalid Custom Certificate",
+				"The certificate chain is incomplete. Any intermediate certificates should follow the certificate in `certificate`, and the root certificate should be in `certificate_authority_certificate`: "+err.Error(),
+			)
+		}
+	}
+
+	if config.ExpiryWarningDays.IsUnknown() {
+		return
+	}
+	warningDays := int64(30)
+	if !config.ExpiryWarningDays.IsNull() {
+		warningDays = config.ExpiryWarningDays.ValueInt64()
+	}
+	notAfter := certs[0].NotAfter
+	switch remaining := time.Until(notAfter); {
+	case remaining <= 0:
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("certificate"),
+			"Custom Certificate has expired",
+			fmt.Sprintf("The certificate expired at %s.", notAfter.UTC().Format(time.RFC3339)),
+		)
+	case warningDays > 0 && remaining < time.Duration(warningDays)*24*time.Hour:
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("certificate"),
+			"Custom Certificate expires soon",
+			fmt.Sprintf("The certificate expires at %s, in %d days.", notAfter.UTC().Format(time.RFC3339), int64(remaining.Hours()/24)),
+		)
+	}
+}
+
+// ModifyPlan sets the details of the certificate in the plan, so that they are known before it is uploaded.
+func (r *customCertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan CustomCertificate
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || plan.Certificate.IsNull() || plan.Certificate.IsUnknown() {
+		return
+	}
+
+	certs, err := parseCertificates(plan.Certificate.ValueString())
+	if err != nil {
+		// This is reported by ValidateConfig.
+		return
+	}
+	plan.setCertificateDetails(certs[0])
+	diags = resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *customCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -120,6 +346,15 @@ func (r *customCertificateResource) Create(ctx context.Context, req resource.Cre
 	}
 	plan.ID = types.StringValue(out.ID)
 	plan.TeamID = types.StringValue(r.client.TeamID(plan.TeamID.ValueString()))
+	certs, err := parseCertificates(plan.Certificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing Custom Certificate",
+			"Could not parse Custom Certificate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	plan.setCertificateDetails(certs[0])
 
 	tflog.Info(ctx, "uploaded custom certificate", map[string]any{
 		"team_id": plan.TeamID.ValueString(),
@@ -162,6 +397,10 @@ func (r *customCertificateResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	if state.Certificate.IsNull() {
+		state.setResponseDetails(out)
+	}
+
 	tflog.Info(ctx, "read certificate", map[string]any{
 		"team_id": state.TeamID.ValueString(),
 		"id":      out.ID,
@@ -174,11 +413,70 @@ func (r *customCertificateResource) Read(ctx context.Context, req resource.ReadR
 	}
 }
 
+// Update only changes the expiry warning, or adopts the PEM attributes of an imported certificate, as a
+// change to any other attribute requires replacement.
 func (r *customCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Updating a Custom Certificate is not supported",
-		"Updating a Custom Certificate is not supported",
-	)
+	var plan CustomCertificate
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state CustomCertificate
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certs, err := parseCertificates(plan.Certificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing Custom Certificate",
+			"Could not parse Custom Certificate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if state.Certificate.IsNull() {
+		out, err := r.client.GetCustomCertificate(ctx, client.GetCustomCertificateRequest{
+			ID:     state.ID.ValueString(),
+			TeamID: state.TeamID.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading Custom Certificate",
+				fmt.Sprintf("Could not get Custom Certificate %s %s, unexpected error: %s",
+					state.TeamID.ValueString(),
+					state.ID.ValueString(),
+					err,
+				),
+			)
+			return
+		}
+		if out.ExpiresAt/1000 != certs[0].NotAfter.Unix() {
+			resp.Diagnostics.AddError(
+				"Error updating Custom Certificate",
+				fmt.Sprintf(
+					"The configured certificate does not match the imported Custom Certificate %s, which expires at %s. Change the certificate, or remove the Custom Certificate from the state so that a new one is uploaded.",
+					state.ID.ValueString(),
+					time.UnixMilli(out.ExpiresAt).UTC().Format(time.RFC3339),
+				),
+			)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	plan.TeamID = state.TeamID
+	plan.setCertificateDetails(certs[0])
+	tflog.Info(ctx, "updated custom certificate", map[string]any{
+		"team_id": plan.TeamID.ValueString(),
+		"id":      plan.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *customCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -214,3 +512,49 @@ func (r *customCertificateResource) Delete(ctx context.Context, req resource.Del
 		"id":      state.ID.ValueString(),
 	})
 }
+
+// ImportState takes an identifier and reads the Custom Certificate from the Vercel API. The PEM attributes
+// are not returned by the API, so they are adopted from the configuration on the next apply.
+func (r *customCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, id, ok := splitInto1Or2(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing Custom Certificate",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/certificate_id\" or \"certificate_id\"", req.ID),
+		)
+		return
+	}
+
+	out, err := r.client.GetCustomCertificate(ctx, client.GetCustomCertificateRequest{
+		ID:     id,
+		TeamID: teamID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Custom Certificate",
+			fmt.Sprintf("Could not get Custom Certificate %s %s, unexpected error: %s",
+				teamID,
+				id,
+				err,
+			),
+		)
+		return
+	}
+
+	result := CustomCertificate{
+		ID:                              types.StringValue(out.ID),
+		TeamID:                          types.StringValue(r.client.TeamID(teamID)),
+		PrivateKey:                      types.StringNull(),
+		Certificate:                     types.StringNull(),
+		CertificateAuthorityCertificate: types.StringNull(),
+		ExpiryWarningDays:               types.Int64Value(30),
+	}
+	result.setResponseDetails(out)
+	tflog.Info(ctx, "imported custom certificate", map[string]any{
+		"team_id": result.TeamID.ValueString(),
+		"id":      result.ID.ValueString(),
+	})
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
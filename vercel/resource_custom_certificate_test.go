@@ -2,9 +2,18 @@ package vercel_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
+	"math/big"
 	"os"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -53,7 +62,60 @@ func testCertKey(t *testing.T) string {
 	return v
 }
 
+// testCertificate is a certificate and private key generated for a test.
+type testCertificate struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM string
+	keyPEM  string
+}
+
+// generateTestCertificate creates a certificate for the common name. If parent is nil, the certificate
+// is a self-signed certificate authority, otherwise it is signed by parent.
+func generateTestCertificate(t *testing.T, commonName string, parent *testCertificate) testCertificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("could not generate key: %s", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(365 * 24 * time.Hour),
+		DNSNames:     []string{commonName},
+	}
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatalf("could not create certificate: %s", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("could not parse certificate: %s", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("could not marshal key: %s", err)
+	}
+	return testCertificate{
+		cert:    cert,
+		key:     key,
+		certPEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		keyPEM:  string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+	}
+}
+
 func TestAcc_CustomCertificateResource(t *testing.T) {
+	authority := generateTestCertificate(t, "Test CA", nil)
+	otherAuthority := generateTestCertificate(t, "Other CA", nil)
+	leaf := generateTestCertificate(t, "test.example.com", &authority)
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testCheckCustomCertificateDoesNotExist(testClient(t), testTeam(t), "vercel_custom_certificate.test"),
@@ -63,6 +125,51 @@ func TestAcc_CustomCertificateResource(t *testing.T) {
 resource "vercel_custom_certificate" "test" {
 	private_key = <<EOT
 %[1]s
+EOT
+	certificate                       = "not a certificate"
+	certificate_authority_certificate = "not a certificate"
+}
+				`, testCertKey(t))),
+				ExpectError: regexp.MustCompile(`Could\s+not\s+parse\s+the\s+certificate`),
+			},
+			{
+				// The private key of the certificate authority does not match the leaf certificate.
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_custom_certificate" "test" {
+	private_key = <<EOT
+%[1]s
+EOT
+	certificate = <<EOT
+%[2]s
+EOT
+	certificate_authority_certificate = <<EOT
+%[3]s
+EOT
+}
+				`, authority.keyPEM, leaf.certPEM, authority.certPEM)),
+				ExpectError: regexp.MustCompile(`The\s+private\s+key\s+is\s+not\s+valid\s+for\s+the\s+certificate`),
+			},
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_custom_certificate" "test" {
+	private_key = <<EOT
+%[1]s
+EOT
+	certificate = <<EOT
+%[2]s
+EOT
+	certificate_authority_certificate = <<EOT
+%[3]s
+EOT
+}
+				`, leaf.keyPEM, leaf.certPEM, otherAuthority.certPEM)),
+				ExpectError: regexp.MustCompile(`The\s+certificate\s+chain\s+is\s+incomplete`),
+			},
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_custom_certificate" "test" {
+	private_key = <<EOT
+%[1]s
 EOT
 	certificate = <<EOT
 %[2]s
@@ -72,8 +179,43 @@ EOT
 EOT
 				}
 				`, testCertKey(t), testCert(t))),
-				Check: resource.TestCheckResourceAttrSet("vercel_custom_certificate.test", "id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vercel_custom_certificate.test", "id"),
+					resource.TestCheckResourceAttrSet("vercel_custom_certificate.test", "not_before"),
+					resource.TestCheckResourceAttrSet("vercel_custom_certificate.test", "not_after"),
+					resource.TestCheckResourceAttrSet("vercel_custom_certificate.test", "issuer"),
+					resource.TestCheckResourceAttr("vercel_custom_certificate.test", "expiry_warning_days", "30"),
+				),
+			},
+			{
+				ResourceName:      "vercel_custom_certificate.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getCustomCertificateImportID("vercel_custom_certificate.test"),
+				ImportStateVerifyIgnore: []string{
+					"private_key",
+					"certificate",
+					"certificate_authority_certificate",
+					"not_before",
+					"issuer",
+					"subject_alternative_names",
+				},
 			},
 		},
 	})
 }
+
+func getCustomCertificateImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return "", fmt.Errorf("no ID is set")
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["team_id"], rs.Primary.ID), nil
+	}
+}
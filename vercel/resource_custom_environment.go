@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -25,6 +27,7 @@ var (
 	_ resource.Resource                = &customEnvironmentResource{}
 	_ resource.ResourceWithConfigure   = &customEnvironmentResource{}
 	_ resource.ResourceWithImportState = &customEnvironmentResource{}
+	_ resource.ResourceWithModifyPlan  = &customEnvironmentResource{}
 )
 
 func newCustomEnvironmentResource() resource.Resource {
@@ -124,6 +127,11 @@ Custom environments are designed as pre-production environments intended for lon
 					},
 				},
 			},
+			"branch_tracking_matches": schema.ListAttribute{
+				Description: "The branches of the project's most recent deployments that match `branch_tracking`, as of when `branch_tracking` was last changed. This is shown at plan time, so the effect of a new pattern can be checked before it is applied.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 		},
 	}
 }
@@ -134,12 +142,13 @@ type BranchTracking struct {
 }
 
 type CustomEnvironment struct {
-	ID             types.String `tfsdk:"id"`
-	TeamID         types.String `tfsdk:"team_id"`
-	ProjectID      types.String `tfsdk:"project_id"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	BranchTracking types.Object `tfsdk:"branch_tracking"`
+	ID                    types.String `tfsdk:"id"`
+	TeamID                types.String `tfsdk:"team_id"`
+	ProjectID             types.String `tfsdk:"project_id"`
+	Name                  types.String `tfsdk:"name"`
+	Description           types.String `tfsdk:"description"`
+	BranchTracking        types.Object `tfsdk:"branch_tracking"`
+	BranchTrackingMatches types.List   `tfsdk:"branch_tracking_matches"`
 }
 
 func (c CustomEnvironment) toCreateRequest(ctx context.Context) (client.CreateCustomEnvironmentRequest, diag.Diagnostics) {
@@ -211,13 +220,133 @@ func convertResponseToModel(res client.CustomEnvironmentResponse) CustomEnvironm
 			})
 	}
 	return CustomEnvironment{
-		ID:             types.StringValue(res.ID),
-		TeamID:         types.StringValue(res.TeamID),
-		ProjectID:      types.StringValue(res.ProjectID),
-		Name:           types.StringValue(res.Slug),
-		Description:    types.StringValue(res.Description),
-		BranchTracking: bt,
+		ID:                    types.StringValue(res.ID),
+		TeamID:                types.StringValue(res.TeamID),
+		ProjectID:             types.StringValue(res.ProjectID),
+		Name:                  types.StringValue(res.Slug),
+		Description:           types.StringValue(res.Description),
+		BranchTracking:        bt,
+		BranchTrackingMatches: types.ListNull(types.StringType),
+	}
+}
+
+// matchesBranchMatcher reports whether a branch would be tracked by a branch matcher.
+func matchesBranchMatcher(bm client.BranchMatcher, branch string) bool {
+	switch bm.Type {
+	case "startsWith":
+		return strings.HasPrefix(branch, bm.Pattern)
+	case "endsWith":
+		return strings.HasSuffix(branch, bm.Pattern)
+	case "equals":
+		return branch == bm.Pattern
+	}
+	return false
+}
+
+// branchTrackingMatches returns the branches of the project's recent deployments that match the branch
+// tracking configuration, with a warning if there are none.
+func branchTrackingMatches(ctx context.Context, c *client.Client, projectID, teamID string, bm *client.BranchMatcher) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	matches := []attr.Value{}
+	if bm == nil {
+		return types.ListValueMust(types.StringType, matches), diags
+	}
+
+	branches, err := c.ListDeploymentBranches(ctx, projectID, teamID)
+	if err != nil {
+		diags.AddError(
+			"Error reading deployment branches",
+			fmt.Sprintf("Could not list the branches of project %s, unexpected error: %s", projectID, err),
+		)
+		return types.ListNull(types.StringType), diags
+	}
+	for _, b := range branches {
+		if matchesBranchMatcher(*bm, b) {
+			matches = append(matches, types.StringValue(b))
+		}
+	}
+	if len(matches) == 0 && len(branches) > 0 {
+		diags.AddAttributeWarning(
+			path.Root("branch_tracking"),
+			"Branch tracking matches no existing branches",
+			fmt.Sprintf("None of the %d branches of the project's recent deployments %s %q.", len(branches), bm.Type, bm.Pattern),
+		)
 	}
+	return types.ListValueMust(types.StringType, matches), diags
+}
+
+// ModifyPlan works out which existing branches match the branch tracking configuration, so that the effect of a
+// pattern can be checked before it is applied. This is only done when the branch tracking changes.
+func (r *customEnvironmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan CustomEnvironment
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state CustomEnvironment
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.BranchTracking.Equal(state.BranchTracking) && !state.BranchTrackingMatches.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("branch_tracking_matches"), state.BranchTrackingMatches)...)
+			return
+		}
+	}
+
+	if plan.BranchTracking.IsUnknown() || plan.ProjectID.IsUnknown() {
+		return
+	}
+	var bm *client.BranchMatcher
+	if !plan.BranchTracking.IsNull() {
+		bt, diags := plan.branchTracking(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() || bt.Pattern.IsUnknown() || bt.Type.IsUnknown() {
+			return
+		}
+		bm = &client.BranchMatcher{
+			Pattern: bt.Pattern.ValueString(),
+			Type:    bt.Type.ValueString(),
+		}
+	}
+	teamID := ""
+	if !plan.TeamID.IsUnknown() {
+		teamID = plan.TeamID.ValueString()
+	}
+
+	matches, diags := branchTrackingMatches(ctx, r.client, plan.ProjectID.ValueString(), teamID, bm)
+	if diags.HasError() {
+		// The branches are only informational, so don't block the plan if they can't be read.
+		tflog.Info(ctx, "unable to determine branch tracking matches", map[string]any{
+			"project_id": plan.ProjectID.ValueString(),
+		})
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("branch_tracking_matches"), matches)...)
+}
+
+// withBranchTrackingMatches sets the branch tracking matches of a result to the planned value. If they were
+// not known at plan time, they are worked out from the result's branch tracking instead.
+func (r *customEnvironmentResource) withBranchTrackingMatches(ctx context.Context, result CustomEnvironment, plan CustomEnvironment, res client.CustomEnvironmentResponse) (CustomEnvironment, diag.Diagnostics) {
+	if !plan.BranchTrackingMatches.IsUnknown() {
+		result.BranchTrackingMatches = plan.BranchTrackingMatches
+		return result, nil
+	}
+	matches, diags := branchTrackingMatches(ctx, r.client, res.ProjectID, res.TeamID, res.BranchMatcher)
+	if diags.HasError() {
+		result.BranchTrackingMatches = types.ListNull(types.StringType)
+		return result, nil
+	}
+	result.BranchTrackingMatches = matches
+	return result, diags
 }
 
 func (r *customEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -249,7 +378,9 @@ func (r *customEnvironmentResource) Create(ctx context.Context, req resource.Cre
 		"total_res":             res,
 	})
 
-	diags = resp.State.Set(ctx, convertResponseToModel(res))
+	result, diags := r.withBranchTrackingMatches(ctx, convertResponseToModel(res), plan, res)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
 }
 
@@ -282,7 +413,9 @@ func (r *customEnvironmentResource) Read(ctx context.Context, req resource.ReadR
 		"custom_environment_id": res.ID,
 	})
 
-	diags = resp.State.Set(ctx, convertResponseToModel(res))
+	result := convertResponseToModel(res)
+	result.BranchTrackingMatches = state.BranchTrackingMatches
+	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
 }
 
@@ -315,7 +448,9 @@ func (r *customEnvironmentResource) Update(ctx context.Context, req resource.Upd
 		"custom_environment_id": res.ID,
 	})
 
-	diags = resp.State.Set(ctx, convertResponseToModel(res))
+	result, diags := r.withBranchTrackingMatches(ctx, convertResponseToModel(res), plan, res)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
 }
 
@@ -374,6 +509,10 @@ func (r *customEnvironmentResource) ImportState(ctx context.Context, req resourc
 		"custom_environment_id": res.ID,
 	})
 
-	diags := resp.State.Set(ctx, convertResponseToModel(res))
+	result := convertResponseToModel(res)
+	if matches, diags := branchTrackingMatches(ctx, r.client, res.ProjectID, res.TeamID, res.BranchMatcher); !diags.HasError() {
+		result.BranchTrackingMatches = matches
+	}
+	diags := resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
 }
@@ -60,6 +60,7 @@ func TestAcc_CustomEnvironmentResource(t *testing.T) {
 					resource.TestCheckResourceAttr("vercel_custom_environment.test_bt", "branch_tracking.type", "startsWith"),
 					resource.TestCheckResourceAttr("vercel_custom_environment.test_bt", "branch_tracking.pattern", "staging-"),
 					resource.TestCheckResourceAttr("vercel_custom_environment.test_bt", "description", "with branch tracking"),
+					resource.TestCheckResourceAttr("vercel_custom_environment.test_bt", "branch_tracking_matches.#", "0"),
 
 					// check project env var
 					resource.TestCheckResourceAttr("vercel_project_environment_variable.test", "custom_environment_ids.#", "1"),
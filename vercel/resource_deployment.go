@@ -6,11 +6,16 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -28,8 +33,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &deploymentResource{}
-	_ resource.ResourceWithConfigure = &deploymentResource{}
+	_ resource.Resource               = &deploymentResource{}
+	_ resource.ResourceWithConfigure  = &deploymentResource{}
+	_ resource.ResourceWithModifyPlan = &deploymentResource{}
 )
 
 func newDeploymentResource() resource.Resource {
@@ -180,6 +186,45 @@ terraform to your Deployment.
 					},
 				},
 			},
+			"functions": schema.MapNestedAttribute{
+				Description:   "Overrides for the configuration of the Vercel Functions matching a glob pattern, such as `api/render/*.js`. This is equivalent to the `functions` field of `vercel.json`.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.Map{mapplanmodifier.RequiresReplace()},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"memory": schema.Int64Attribute{
+							Description: "The amount of memory, in MB, available to the functions. This is not supported for projects that use fluid compute, where memory is determined by `resource_config.function_default_cpu_type` on the project.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(128, 3009),
+							},
+						},
+						"max_duration": schema.Int64Attribute{
+							Description: "The maximum duration, in seconds, that the functions can run for.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 900),
+							},
+						},
+						"regions": schema.SetAttribute{
+							Description: "The regions the functions are deployed to, overriding the project's default regions.",
+							Optional:    true,
+							ElementType: types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(validateServerlessFunctionRegion()),
+							},
+						},
+						"runtime": schema.StringAttribute{
+							Description: "The npm package name and version of a community runtime to use for the functions, such as `vercel-php@0.7.1`.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^.+@.+$`), "Value must be in the format `package@version`"),
+							},
+						},
+					},
+				},
+			},
 			"delete_on_destroy": schema.BoolAttribute{
 				Description: "Set to true to hard delete the Vercel deployment when destroying the Terraform resource. If unspecified, deployments are retained indefinitely. Note that deleted deployments are not recoverable.",
 				Optional:    true,
@@ -203,6 +248,38 @@ type ProjectSettings struct {
 	RootDirectory   types.String `tfsdk:"root_directory"`
 }
 
+// DeploymentFunction represents the terraform state for a single entry of a deployment -> functions
+// map, which overrides the configuration of the functions matching a glob pattern.
+type DeploymentFunction struct {
+	Memory      types.Int64  `tfsdk:"memory"`
+	MaxDuration types.Int64  `tfsdk:"max_duration"`
+	Regions     types.Set    `tfsdk:"regions"`
+	Runtime     types.String `tfsdk:"runtime"`
+}
+
+// toRequest converts the function overrides into the format of the `functions` field of vercel.json.
+func (f DeploymentFunction) toRequest(ctx context.Context) (map[string]any, diag.Diagnostics) {
+	res := map[string]any{}
+	if !f.Memory.IsNull() {
+		res["memory"] = f.Memory.ValueInt64()
+	}
+	if !f.MaxDuration.IsNull() {
+		res["maxDuration"] = f.MaxDuration.ValueInt64()
+	}
+	if !f.Runtime.IsNull() {
+		res["runtime"] = f.Runtime.ValueString()
+	}
+	if !f.Regions.IsNull() {
+		var regions []string
+		diags := f.Regions.ElementsAs(ctx, &regions, false)
+		if diags.HasError() {
+			return nil, diags
+		}
+		res["regions"] = regions
+	}
+	return res, nil
+}
+
 // Deployment represents the terraform state for a deployment resource.
 type Deployment struct {
 	Domains             types.List   `tfsdk:"domains"`
@@ -214,6 +291,7 @@ type Deployment struct {
 	ProjectID           types.String `tfsdk:"project_id"`
 	PathPrefix          types.String `tfsdk:"path_prefix"`
 	ProjectSettings     types.Object `tfsdk:"project_settings"`
+	Functions           types.Map    `tfsdk:"functions"`
 	TeamID              types.String `tfsdk:"team_id"`
 	URL                 types.String `tfsdk:"url"`
 	DeleteOnDestroy     types.Bool   `tfsdk:"delete_on_destroy"`
@@ -301,6 +379,22 @@ func normaliseFilename(filename string, pathPrefix types.String) string {
 	return strings.TrimPrefix(filename, withSlashAtEndIfNeeded(filepath.ToSlash(pathPrefix.ValueString())))
 }
 
+// File modes use the same representation as the Vercel CLI, which sends the result of a
+// node fs.stat call: the file type bits followed by the permission bits.
+const (
+	deploymentFileModeRegular = 0o100000
+	deploymentFileModeSymlink = 0o120000
+	deploymentFileModeType    = 0o170000
+)
+
+// deploymentFileMode converts a FileMode into the mode expected by the Vercel API.
+func deploymentFileMode(mode os.FileMode) int {
+	if mode&os.ModeSymlink != 0 {
+		return deploymentFileModeSymlink | int(mode.Perm())
+	}
+	return deploymentFileModeRegular | int(mode.Perm())
+}
+
 // getFiles is a helper for turning the terraform deployment state into a set of client.DeploymentFile
 // structs, ready to hit the API with. It also returns a map of files by sha, which is used to quickly
 // look up any missing SHAs from the create deployment resposnse.
@@ -310,19 +404,27 @@ func getFiles(unparsedFiles map[string]string) ([]client.DeploymentFile, map[str
 
 	for filename, rawSizeAndSha := range unparsedFiles {
 		sizeSha := strings.Split(rawSizeAndSha, "~")
-		if len(sizeSha) != 2 {
-			return nil, nil, fmt.Errorf("expected file to have format `filename: size~sha`, but could not parse")
+		if len(sizeSha) != 2 && len(sizeSha) != 3 {
+			return nil, nil, fmt.Errorf("expected file to have format `filename: size~sha` or `filename: size~sha~mode`, but could not parse")
 		}
 		size, err := strconv.Atoi(sizeSha[0])
 		if err != nil {
 			return nil, nil, fmt.Errorf("unable to parse file size: %w", err)
 		}
 		sha := sizeSha[1]
+		var mode int64
+		if len(sizeSha) == 3 {
+			mode, err = strconv.ParseInt(sizeSha[2], 8, 64)
+			if err != nil {
+				return nil, nil, fmt.Errorf("unable to parse file mode: %w", err)
+			}
+		}
 
 		file := client.DeploymentFile{
 			File: filename,
 			Sha:  sha,
 			Size: size,
+			Mode: int(mode),
 		}
 		files = append(files, file)
 
@@ -334,11 +436,35 @@ func getFiles(unparsedFiles map[string]string) ([]client.DeploymentFile, map[str
 			File: filename,
 			Sha:  sha,
 			Size: size,
+			Mode: int(mode),
 		}
 	}
 	return files, filesBySha, nil
 }
 
+// readDeploymentFile returns the content to upload for a deployment file. If the file has a
+// mode, it determines whether the file is uploaded as a symlink. Otherwise, symlinks are
+// detected from the filesystem. The content of a symlink is the path it points to.
+func readDeploymentFile(f client.DeploymentFile) ([]byte, error) {
+	isSymlink := f.Mode&deploymentFileModeType == deploymentFileModeSymlink
+	if f.Mode == 0 {
+		fileInfo, err := os.Lstat(f.File)
+		if err != nil {
+			return nil, fmt.Errorf("could not get info for file: %w", err)
+		}
+		isSymlink = fileInfo.Mode()&os.ModeSymlink != 0
+	}
+
+	if isSymlink {
+		linkTarget, err := os.Readlink(f.File)
+		if err != nil {
+			return nil, fmt.Errorf("could not read symlink: %w", err)
+		}
+		return []byte(linkTarget), nil
+	}
+	return os.ReadFile(f.File)
+}
+
 var projectSettingsAttrType = types.ObjectType{
 	AttrTypes: map[string]attr.Type{
 		"build_command":    types.StringType,
@@ -456,6 +582,7 @@ func convertResponseToDeployment(ctx context.Context, response client.Deployment
 		Files:               plan.Files,
 		PathPrefix:          fillStringNull(plan.PathPrefix),
 		ProjectSettings:     psObj,
+		Functions:           plan.Functions,
 		DeleteOnDestroy:     plan.DeleteOnDestroy,
 		Ref:                 ref,
 		CustomEnvironmentID: customEnvironmentID,
@@ -487,6 +614,45 @@ func (r *deploymentResource) ValidateConfig(ctx context.Context, req resource.Va
 	}
 }
 
+// ModifyPlan validates any function overrides against the project's resource configuration, so that
+// invalid overrides are reported before the deployment is created.
+func (r *deploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		// Deployments are never updated, so only new deployments need validating.
+		return
+	}
+	var plan Deployment
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Functions.IsNull() || plan.Functions.IsUnknown() || plan.ProjectID.IsUnknown() {
+		return
+	}
+
+	var functions map[string]DeploymentFunction
+	diags = plan.Functions.ElementsAs(ctx, &functions, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hasMemory := false
+	for _, f := range functions {
+		hasMemory = hasMemory || !f.Memory.IsNull()
+	}
+	if !hasMemory {
+		return
+	}
+
+	pr, err := r.client.GetProject(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString())
+	if err != nil {
+		// The project is validated again when the deployment is created, which reports any error.
+		return
+	}
+	resp.Diagnostics.Append(validateFunctionsForResourceConfig(functions, pr.ResourceConfig)...)
+}
+
 func validatePrebuiltBuilds(diags AddErrorer, config Deployment, files []client.DeploymentFile) {
 	buildsFilePath, ok := getPrebuiltBuildsFile(files)
 	if !ok {
@@ -625,6 +791,28 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	var functions map[string]DeploymentFunction
+	if !plan.Functions.IsNull() && !plan.Functions.IsUnknown() {
+		diags = plan.Functions.ElementsAs(ctx, &functions, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	resp.Diagnostics.Append(validateFunctionsForResourceConfig(functions, pr.ResourceConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	functionsRequest := map[string]any{}
+	for pattern, f := range functions {
+		fr, diags := f.toRequest(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		functionsRequest[pattern] = fr
+	}
+
 	// Prepare git metadata BEFORE normalising filenames so we use real filesystem paths
 	gitMeta := prepareGitMetadata(ctx, files, plan.Ref.ValueString(), pr)
 
@@ -645,6 +833,7 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 
 	cdr := client.CreateDeploymentRequest{
 		Files:                     files,
+		Functions:                 functionsRequest,
 		Environment:               filterNullFromMap(environment),
 		ProjectID:                 plan.ProjectID.ValueString(),
 		ProjectSettings:           ps.toRequest(),
@@ -669,13 +858,12 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		for _, sha := range mfErr.Missing {
 			f := filesBySha[sha]
 
-			// Get file info to check if it's a symlink
-			fileInfo, err := os.Lstat(f.File)
+			content, err := readDeploymentFile(f)
 			if err != nil {
 				resp.Diagnostics.AddError(
-					"Error checking file",
+					"Error reading file",
 					fmt.Sprintf(
-						"Could not get info for file %s, unexpected error: %s",
+						"Could not read file %s, unexpected error: %s",
 						f.File,
 						err,
 					),
@@ -683,63 +871,11 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 				return
 			}
 
-			var content []byte
-
-			if fileInfo.Mode()&os.ModeSymlink != 0 {
-				// It's a symlink - read the target path
-				linkTarget, err := os.Readlink(f.File)
-				if err != nil {
-					resp.Diagnostics.AddError(
-						"Error reading symlink",
-						fmt.Sprintf(
-							"Could not read symlink %s, unexpected error: %s",
-							f.File,
-							err,
-						),
-					)
-					return
-				}
-				// For symlinks, the content is the target path as string
-				err = r.client.CreateFile(ctx, client.CreateFileRequest{
-					Filename: normaliseFilename(f.File, plan.PathPrefix),
-					SHA:      f.Sha,
-					Content:  linkTarget, // Just use the target path as content
-					TeamID:   plan.TeamID.ValueString(),
-				})
-				if err != nil {
-					resp.Diagnostics.AddError(
-						"Error uploading symlink file",
-						fmt.Sprintf(
-							"Could not upload symlink %s, unexpected error: %s",
-							f.File,
-							err,
-						),
-					)
-					return
-				}
-			} else {
-				// Regular file - read its content
-				content, err = os.ReadFile(f.File)
-				if err != nil {
-					resp.Diagnostics.AddError(
-						"Error reading file",
-						fmt.Sprintf(
-							"Could not read file %s, unexpected error: %s",
-							f.File,
-							err,
-						),
-					)
-					return
-				}
-			}
-
 			err = r.client.CreateFile(ctx, client.CreateFileRequest{
 				Filename: normaliseFilename(f.File, plan.PathPrefix),
 				SHA:      f.Sha,
 				Content:  string(content),
 				TeamID:   plan.TeamID.ValueString(),
-				// If we need to preserve the file mode, add it here
-				// Mode:     uint32(fileInfo.Mode()),
 			})
 			if err != nil {
 				resp.Diagnostics.AddError(
@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -154,6 +155,80 @@ func TestAcc_DeploymentWithProjectSettings(t *testing.T) {
 	})
 }
 
+func TestAcc_DeploymentWithFunctions(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:             noopDestroyCheck,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      cfg(testAccDeploymentWithFunctionsFluid(projectSuffix)),
+				ExpectError: regexp.MustCompile(`uses fluid compute`),
+			},
+			{
+				Config: cfg(testAccDeploymentWithFunctions(projectSuffix)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeploymentExists(testClient(t), "vercel_deployment.test", ""),
+					resource.TestCheckResourceAttr("vercel_deployment.test", "functions.api/*.js.max_duration", "30"),
+					resource.TestCheckTypeSetElemAttr("vercel_deployment.test", "functions.api/*.js.regions.*", "iad1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeploymentWithFunctions(projectSuffix string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-deployment-functions-%[1]s"
+}
+
+data "vercel_file" "function" {
+  path = "examples/functions/api/hello.js"
+}
+
+resource "vercel_deployment" "test" {
+  project_id  = vercel_project.test.id
+  files       = data.vercel_file.function.file
+  path_prefix = "examples/functions"
+
+  functions = {
+    "api/*.js" = {
+      max_duration = 30
+      regions      = ["iad1"]
+    }
+  }
+}
+`, projectSuffix)
+}
+
+func testAccDeploymentWithFunctionsFluid(projectSuffix string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-deployment-functions-%[1]s"
+  resource_config = {
+    fluid = true
+  }
+}
+
+data "vercel_file" "function" {
+  path = "examples/functions/api/hello.js"
+}
+
+resource "vercel_deployment" "test" {
+  project_id  = vercel_project.test.id
+  files       = data.vercel_file.function.file
+  path_prefix = "examples/functions"
+
+  functions = {
+    "api/*.js" = {
+      memory = 1024
+    }
+  }
+}
+`, projectSuffix)
+}
+
 func TestAcc_DeploymentWithRootDirectoryOverride(t *testing.T) {
 	projectSuffix := acctest.RandString(16)
 	resource.Test(t, resource.TestCase{
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -64,7 +65,9 @@ Provides a DNS Record resource.
 
 DNS records are instructions that live in authoritative DNS servers and provide information about a domain.
 
-~> The ` + "`value` field" + ` must be specified on all DNS record types except ` + "`SRV`" + `. When using ` + "`SRV`" + ` DNS records, the ` + "`srv`" + ` field must be specified.
+~> The ` + "`value` field" + ` must be specified on all DNS record types except ` + "`SRV`" + ` and ` + "`HTTPS`" + `. When using ` + "`SRV`" + ` DNS records, the ` + "`srv`" + ` field must be specified, and when using ` + "`HTTPS`" + ` DNS records, the ` + "`https`" + ` field must be specified. ` + "`CAA`" + ` records can use either the ` + "`value`" + ` or the ` + "`caa`" + ` field.
+
+Values are validated according to the record type, and equivalent values, such as hostnames with or without a trailing dot, or TXT values split into quoted strings, do not cause a difference.
 
 For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/concepts/projects/custom-domains#dns-records)
         `,
@@ -88,16 +91,16 @@ For more detailed information, please see the [Vercel documentation](https://ver
 				Required:    true,
 			},
 			"type": schema.StringAttribute{
-				Description:   "The type of DNS record. Available types: " + "`A`" + ", " + "`AAAA`" + ", " + "`ALIAS`" + ", " + "`CAA`" + ", " + "`CNAME`" + ", " + "`MX`" + ", " + "`NS`" + ", " + "`SRV`" + ", " + "`TXT`" + ".",
+				Description:   "The type of DNS record. Available types: " + "`A`" + ", " + "`AAAA`" + ", " + "`ALIAS`" + ", " + "`CAA`" + ", " + "`CNAME`" + ", " + "`HTTPS`" + ", " + "`MX`" + ", " + "`NS`" + ", " + "`SRV`" + ", " + "`TXT`" + ".",
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 				Required:      true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("A", "AAAA", "ALIAS", "CAA", "CNAME", "MX", "NS", "SRV", "TXT"),
+					stringvalidator.OneOf("A", "AAAA", "ALIAS", "CAA", "CNAME", "HTTPS", "MX", "NS", "SRV", "TXT"),
 				},
 			},
 			"value": schema.StringAttribute{
-				// required if any record type apart from SRV.
-				Description: "The value of the DNS record. The format depends on the 'type' property.\nFor an 'A' record, this should be a valid IPv4 address.\nFor an 'AAAA' record, this should be an IPv6 address.\nFor 'ALIAS' records, this should be a hostname.\nFor 'CAA' records, this should specify specify which Certificate Authorities (CAs) are allowed to issue certificates for the domain.\nFor 'CNAME' records, this should be a different domain name.\nFor 'MX' records, this should specify the mail server responsible for accepting messages on behalf of the domain name.\nFor 'TXT' records, this can contain arbitrary text.",
+				// required if any record type apart from SRV and HTTPS, or CAA when the caa block is used.
+				Description: "The value of the DNS record. The format depends on the 'type' property.\nFor an 'A' record, this should be a valid IPv4 address.\nFor an 'AAAA' record, this should be an IPv6 address.\nFor 'ALIAS' records, this should be a hostname.\nFor 'CAA' records, this should specify specify which Certificate Authorities (CAs) are allowed to issue certificates for the domain, in the format `{flags} {tag} \"{value}\"`. Alternatively, use the `caa` attribute.\nFor 'CNAME' records, this should be a different domain name.\nFor 'MX' records, this should specify the mail server responsible for accepting messages on behalf of the domain name.\nFor 'NS' records, this should be the hostname of a name server.\nFor 'TXT' records, this can contain arbitrary text. Long values may also be given as several quoted strings, such as `\"first part\" \"second part\"`.",
 				Optional:    true,
 			},
 			"ttl": schema.Int64Attribute{
@@ -160,6 +163,53 @@ For more detailed information, please see the [Vercel documentation](https://ver
 					},
 				},
 			},
+			"caa": schema.SingleNestedAttribute{
+				Description: "Settings for a CAA record. This can be used instead of the `value` attribute.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"flags": schema.Int64Attribute{
+						Description: "The flags of the record. Set this to `128` to mark the property as critical, otherwise `0`.",
+						Required:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+							int64validator.AtMost(255),
+						},
+					},
+					"tag": schema.StringAttribute{
+						Description: "The property the record controls. One of `issue`, `issuewild`, `iodef`, `issuemail` or `issuevmc`.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(caaTags...),
+						},
+					},
+					"value": schema.StringAttribute{
+						Description: "The value of the property, such as the domain of a Certificate Authority for `issue`, or a URL to report violations to for `iodef`.",
+						Required:    true,
+					},
+				},
+			},
+			"https": schema.SingleNestedAttribute{
+				Description: "Settings for an HTTPS record.",
+				Optional:    true, // required for HTTPS records.
+				Attributes: map[string]schema.Attribute{
+					"priority": schema.Int64Attribute{
+						Description: "The priority of the record. `0` makes the record an alias for the target, and any other value gives the preference of the record, lower values being more preferred.",
+						Required:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+							int64validator.AtMost(65535),
+						},
+					},
+					"target": schema.StringAttribute{
+						Description: "The hostname of the alternative endpoint, or `.` to use the name of the record itself.",
+						Required:    true,
+					},
+					"params": schema.StringAttribute{
+						Description: "The service parameters of the record, separated by spaces, such as `alpn=h2,h3 ipv4hint=76.76.21.21`.",
+						Optional:    true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -181,6 +231,36 @@ var srvAttrType = types.ObjectType{
 	},
 }
 
+// CAA reflects the state terraform stores internally for a nested CAA Record.
+type CAA struct {
+	Flags types.Int64  `tfsdk:"flags"`
+	Tag   types.String `tfsdk:"tag"`
+	Value types.String `tfsdk:"value"`
+}
+
+var caaAttrType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"flags": types.Int64Type,
+		"tag":   types.StringType,
+		"value": types.StringType,
+	},
+}
+
+// HTTPS reflects the state terraform stores internally for a nested HTTPS Record.
+type HTTPS struct {
+	Priority types.Int64  `tfsdk:"priority"`
+	Target   types.String `tfsdk:"target"`
+	Params   types.String `tfsdk:"params"`
+}
+
+var httpsAttrType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"priority": types.Int64Type,
+		"target":   types.StringType,
+		"params":   types.StringType,
+	},
+}
+
 // DNSRecord reflects the state terraform stores internally for a DNS Record.
 type DNSRecord struct {
 	ID         types.String `tfsdk:"id"`
@@ -188,6 +268,8 @@ type DNSRecord struct {
 	MXPriority types.Int64  `tfsdk:"mx_priority"`
 	Name       types.String `tfsdk:"name"`
 	SRV        types.Object `tfsdk:"srv"`
+	CAA        types.Object `tfsdk:"caa"`
+	HTTPS      types.Object `tfsdk:"https"`
 	TTL        types.Int64  `tfsdk:"ttl"`
 	TeamID     types.String `tfsdk:"team_id"`
 	Type       types.String `tfsdk:"type"`
@@ -195,6 +277,35 @@ type DNSRecord struct {
 	Comment    types.String `tfsdk:"comment"`
 }
 
+// value returns the value to send to the API. For CAA records configured with the caa attribute, this
+// is built from its fields.
+func (d DNSRecord) value() *string {
+	if d.CAA.IsNull() || d.CAA.IsUnknown() {
+		return d.Value.ValueStringPointer()
+	}
+	var c CAA
+	_ = d.CAA.As(context.Background(), &c, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
+	value := caaRecord{
+		Flags: c.Flags.ValueInt64(),
+		Tag:   c.Tag.ValueString(),
+		Value: c.Value.ValueString(),
+	}.String()
+	return &value
+}
+
+func (d DNSRecord) https() *client.HTTPS {
+	if d.HTTPS.IsNull() || d.HTTPS.IsUnknown() {
+		return nil
+	}
+	var h HTTPS
+	_ = d.HTTPS.As(context.Background(), &h, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
+	return &client.HTTPS{
+		Priority: h.Priority.ValueInt64(),
+		Target:   h.Target.ValueString(),
+		Params:   h.Params.ValueString(),
+	}
+}
+
 func (d DNSRecord) toCreateDNSRecordRequest() client.CreateDNSRecordRequest {
 	var srv *client.SRV = nil
 	if d.Type.ValueString() == "SRV" {
@@ -210,14 +321,19 @@ func (d DNSRecord) toCreateDNSRecordRequest() client.CreateDNSRecordRequest {
 		}
 	}
 
+	var value string
+	if v := d.value(); v != nil {
+		value = *v
+	}
 	return client.CreateDNSRecordRequest{
 		Domain:     d.Domain.ValueString(),
 		MXPriority: d.MXPriority.ValueInt64(),
 		Name:       d.Name.ValueString(),
 		TTL:        d.TTL.ValueInt64(),
 		Type:       d.Type.ValueString(),
-		Value:      d.Value.ValueString(),
+		Value:      value,
 		SRV:        srv,
+		HTTPS:      d.https(),
 		Comment:    d.Comment.ValueString(),
 	}
 }
@@ -248,25 +364,42 @@ func (d DNSRecord) toUpdateRequest() client.UpdateDNSRecordRequest {
 		MXPriority: d.MXPriority.ValueInt64Pointer(),
 		Name:       d.Name.ValueStringPointer(),
 		SRV:        srv,
+		HTTPS:      d.https(),
 		TTL:        ttlPtr,
-		Value:      d.Value.ValueStringPointer(),
+		Value:      d.value(),
 		Comment:    d.Comment.ValueString(),
 	}
 }
 
-func convertResponseToDNSRecord(r client.DNSRecord, value types.String, srvObj types.Object) (record DNSRecord, err error) {
+// equivalentDNSRecordValue returns the prior value if it is equivalent to the value returned by the API,
+// so that formatting differences, such as a trailing dot on a hostname, don't cause a diff.
+func equivalentDNSRecordValue(recordType, value string, prior types.String) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() && normaliseDNSRecordValue(recordType, value) == normaliseDNSRecordValue(recordType, prior.ValueString()) {
+		return prior
+	}
+	return types.StringValue(value)
+}
+
+// convertResponseToDNSRecord converts a DNS record returned by the API into the terraform state. The prior
+// record, from the plan or the state, is used to keep the user's formatting of equivalent values, and to
+// decide whether a CAA record is represented with the value or the caa attribute.
+func convertResponseToDNSRecord(r client.DNSRecord, prior DNSRecord) (record DNSRecord, err error) {
 	record = DNSRecord{
 		Domain:     types.StringValue(r.Domain),
 		ID:         types.StringValue(r.ID),
 		MXPriority: types.Int64Null(),
 		Name:       types.StringValue(r.Name),
+		SRV:        types.ObjectNull(srvAttrType.AttrTypes),
+		CAA:        types.ObjectNull(caaAttrType.AttrTypes),
+		HTTPS:      types.ObjectNull(httpsAttrType.AttrTypes),
 		TTL:        types.Int64Value(r.TTL),
 		TeamID:     toTeamID(r.TeamID),
 		Type:       types.StringValue(r.RecordType),
 		Comment:    types.StringValue(r.Comment),
 	}
 
-	if r.RecordType == "SRV" {
+	switch r.RecordType {
+	case "SRV":
 		// The returned 'Value' field is comprised of the various parts of the SRV block.
 		// So instead, we want to parse the SRV block back out.
 		split := strings.Split(r.Value, " ")
@@ -291,9 +424,9 @@ func convertResponseToDNSRecord(r client.DNSRecord, value types.String, srvObj t
 		}
 		// Preserve user formatting for target (without trailing dot) if planned target matches
 		targetVal := types.StringValue(target)
-		if !srvObj.IsNull() && !srvObj.IsUnknown() {
+		if !prior.SRV.IsNull() && !prior.SRV.IsUnknown() {
 			var s SRV
-			_ = srvObj.As(context.Background(), &s, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
+			_ = prior.SRV.As(context.Background(), &s, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
 			if fmt.Sprintf("%s.", s.Target.ValueString()) == targetVal.ValueString() {
 				targetVal = s.Target
 			}
@@ -307,9 +440,54 @@ func convertResponseToDNSRecord(r client.DNSRecord, value types.String, srvObj t
 		// SRV records have no value
 		record.Value = types.StringNull()
 		return record, nil
-	}
-
-	if r.RecordType == "MX" {
+	case "HTTPS":
+		// As with SRV records, the returned 'Value' field is comprised of the parts of the HTTPS block.
+		split := strings.Fields(r.Value)
+		if len(split) < 2 {
+			return record, fmt.Errorf("expected a value '{priority} {target} {params}', but got %s", r.Value)
+		}
+		priority, err := strconv.Atoi(split[0])
+		if err != nil {
+			return record, fmt.Errorf("expected HTTPS record priority to be an int, but got %s", split[0])
+		}
+		var h HTTPS
+		if !prior.HTTPS.IsNull() && !prior.HTTPS.IsUnknown() {
+			_ = prior.HTTPS.As(context.Background(), &h, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
+		}
+		params := types.StringValue(strings.Join(split[2:], " "))
+		if h.Params.ValueString() != "" && strings.Join(strings.Fields(h.Params.ValueString()), " ") == params.ValueString() {
+			params = h.Params
+		}
+		if params.ValueString() == "" && h.Params.IsNull() {
+			params = types.StringNull()
+		}
+		target := types.StringValue(split[1])
+		if split[1] != "." {
+			target = equivalentDNSRecordValue("CNAME", split[1], h.Target)
+		}
+		record.HTTPS = types.ObjectValueMust(httpsAttrType.AttrTypes, map[string]attr.Value{
+			"priority": types.Int64Value(int64(priority)),
+			"target":   target,
+			"params":   params,
+		})
+		record.Value = types.StringNull()
+		return record, nil
+	case "CAA":
+		if prior.CAA.IsNull() || prior.CAA.IsUnknown() {
+			break
+		}
+		caa, err := parseCAARecord(r.Value)
+		if err != nil {
+			return record, err
+		}
+		record.CAA = types.ObjectValueMust(caaAttrType.AttrTypes, map[string]attr.Value{
+			"flags": types.Int64Value(caa.Flags),
+			"tag":   types.StringValue(caa.Tag),
+			"value": types.StringValue(caa.Value),
+		})
+		record.Value = types.StringNull()
+		return record, nil
+	case "MX":
 		split := strings.Split(r.Value, " ")
 		if len(split) != 2 {
 			return record, fmt.Errorf("expected a 2 part value '{priority} {value}', but got %s", r.Value)
@@ -320,21 +498,11 @@ func convertResponseToDNSRecord(r client.DNSRecord, value types.String, srvObj t
 		}
 
 		record.MXPriority = types.Int64Value(int64(priority))
-		record.Value = types.StringValue(split[1])
-		if split[1] == fmt.Sprintf("%s.", value.ValueString()) {
-			record.Value = value
-		}
-		// Ensure SRV is properly initialized for non-SRV records
-		record.SRV = types.ObjectNull(srvAttrType.AttrTypes)
+		record.Value = equivalentDNSRecordValue(r.RecordType, split[1], prior.Value)
 		return record, nil
 	}
 
-	record.Value = types.StringValue(r.Value)
-	if r.Value == fmt.Sprintf("%s.", value.ValueString()) {
-		record.Value = value
-	}
-	// Ensure SRV is properly initialized for non-SRV records
-	record.SRV = types.ObjectNull(srvAttrType.AttrTypes)
+	record.Value = equivalentDNSRecordValue(r.RecordType, r.Value, prior.Value)
 	return record, nil
 }
 
@@ -347,47 +515,106 @@ func (r *dnsRecordResource) ValidateConfig(ctx context.Context, req resource.Val
 		return
 	}
 
-	if config.Type.ValueString() == "SRV" && (config.SRV.IsNull() || config.SRV.IsUnknown()) {
+	recordType := config.Type.ValueString()
+	if recordType == "SRV" && (config.SRV.IsNull() || config.SRV.IsUnknown()) {
 		resp.Diagnostics.AddError(
 			"DNS Record Invalid",
 			"A DNS Record type of 'SRV' requires the `srv` attribute to be set",
 		)
 	}
 
-	if config.Type.ValueString() != "SRV" && config.Value.IsNull() {
+	if recordType == "HTTPS" && config.HTTPS.IsNull() {
+		resp.Diagnostics.AddError(
+			"DNS Record Invalid",
+			"A DNS Record type of 'HTTPS' requires the `https` attribute to be set",
+		)
+	}
+
+	if recordType == "CAA" && config.Value.IsNull() && config.CAA.IsNull() {
+		resp.Diagnostics.AddError(
+			"DNS Record Invalid",
+			"A DNS Record type of 'CAA' requires either the `value` or the `caa` attribute to be set",
+		)
+	}
+
+	if recordType == "CAA" && !config.Value.IsNull() && !config.CAA.IsNull() {
+		resp.Diagnostics.AddError(
+			"DNS Record Invalid",
+			"Only one of the `value` and `caa` attributes should be set",
+		)
+	}
+
+	if recordType != "SRV" && recordType != "HTTPS" && recordType != "CAA" && config.Value.IsNull() {
 		resp.Diagnostics.AddError(
 			"DNS Record Invalid",
-			fmt.Sprintf("The `value` attribute must be set on records of `type` '%s'", config.Type.ValueString()),
+			fmt.Sprintf("The `value` attribute must be set on records of `type` '%s'", recordType),
 		)
 	}
 
-	if config.Type.ValueString() == "SRV" && !config.Value.IsNull() {
+	if (recordType == "SRV" || recordType == "HTTPS") && !config.Value.IsNull() {
 		resp.Diagnostics.AddError(
 			"DNS Record Invalid",
-			"The `value` attribute should not be set on records of `type` 'SRV'",
+			fmt.Sprintf("The `value` attribute should not be set on records of `type` '%s'", recordType),
 		)
 	}
 
-	if config.Type.ValueString() != "SRV" && !config.SRV.IsNull() && !config.SRV.IsUnknown() {
+	if recordType != "SRV" && !config.SRV.IsNull() && !config.SRV.IsUnknown() {
 		resp.Diagnostics.AddError(
 			"DNS Record Invalid",
 			"The `srv` attribute should only be set on records of `type` 'SRV'",
 		)
 	}
 
-	if config.Type.ValueString() != "MX" && !config.MXPriority.IsNull() {
+	if recordType != "HTTPS" && !config.HTTPS.IsNull() {
+		resp.Diagnostics.AddError(
+			"DNS Record Invalid",
+			"The `https` attribute should only be set on records of `type` 'HTTPS'",
+		)
+	}
+
+	if recordType != "CAA" && !config.CAA.IsNull() {
+		resp.Diagnostics.AddError(
+			"DNS Record Invalid",
+			"The `caa` attribute should only be set on records of `type` 'CAA'",
+		)
+	}
+
+	if recordType != "MX" && !config.MXPriority.IsNull() {
 		resp.Diagnostics.AddError(
 			"DNS Record Invalid",
 			"The `mx_priority` attribute should only be set on records of `type` 'MX'",
 		)
 	}
 
-	if config.Type.ValueString() == "MX" && config.MXPriority.IsNull() {
+	if recordType == "MX" && config.MXPriority.IsNull() {
 		resp.Diagnostics.AddError(
 			"DNS Record Invalid",
 			"A DNS Record type of 'MX' requires the `mx_priority` attribute to be set",
 		)
 	}
+
+	if !config.Type.IsUnknown() && !config.Value.IsNull() && !config.Value.IsUnknown() {
+		if err := validateDNSRecordValue(recordType, config.Value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("value"),
+				"DNS Record Invalid",
+				fmt.Sprintf("The `value` attribute is invalid: %s", err),
+			)
+		}
+	}
+
+	if !config.HTTPS.IsNull() && !config.HTTPS.IsUnknown() {
+		var h HTTPS
+		resp.Diagnostics.Append(config.HTTPS.As(ctx, &h, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})...)
+		target := h.Target.ValueString()
+		if !h.Target.IsUnknown() && target != "." && validateDNSRecordValue("CNAME", target) != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("https").AtName("target"),
+				"DNS Record Invalid",
+				fmt.Sprintf("The `https` target must be a hostname or `.`, but got %s", target),
+			)
+		}
+	}
 }
 
 // Create will create a DNS record within Vercel by calling the Vercel API.
@@ -409,7 +636,7 @@ func (r *dnsRecordResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	result, err := convertResponseToDNSRecord(out, plan.Value, plan.SRV)
+	result, err := convertResponseToDNSRecord(out, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing DNS Record response",
@@ -457,7 +684,7 @@ func (r *dnsRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	result, err := convertResponseToDNSRecord(out, state.Value, state.SRV)
+	result, err := convertResponseToDNSRecord(out, state)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing DNS Record response",
@@ -513,7 +740,7 @@ func (r *dnsRecordResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	result, err := convertResponseToDNSRecord(out, plan.Value, plan.SRV)
+	result, err := convertResponseToDNSRecord(out, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing DNS Record response",
@@ -591,7 +818,7 @@ func (r *dnsRecordResource) ImportState(ctx context.Context, req resource.Import
 		return
 	}
 
-	result, err := convertResponseToDNSRecord(out, types.String{}, types.ObjectNull(srvAttrType.AttrTypes))
+	result, err := convertResponseToDNSRecord(out, DNSRecord{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error processing DNS Record response",
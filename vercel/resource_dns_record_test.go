@@ -3,6 +3,7 @@ package vercel_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -65,8 +66,15 @@ func TestAcc_DNSRecord(t *testing.T) {
 			testAccDNSRecordDestroy(testClient(t), "vercel_dns_record.mx", testTeam(t)),
 			testAccDNSRecordDestroy(testClient(t), "vercel_dns_record.srv", testTeam(t)),
 			testAccDNSRecordDestroy(testClient(t), "vercel_dns_record.txt", testTeam(t)),
+			testAccDNSRecordDestroy(testClient(t), "vercel_dns_record.txt_quoted", testTeam(t)),
+			testAccDNSRecordDestroy(testClient(t), "vercel_dns_record.caa_block", testTeam(t)),
+			testAccDNSRecordDestroy(testClient(t), "vercel_dns_record.https", testTeam(t)),
 		),
 		Steps: []resource.TestStep{
+			{
+				Config:      cfg(testAccDNSRecordConfigInvalidValue(testDomain(t), nameSuffix)),
+				ExpectError: regexp.MustCompile(`an\s+A\s+record\s+value\s+must\s+be\s+an\s+IPv4\s+address`),
+			},
 			{
 				Config: cfg(testAccDNSRecordConfig(testDomain(t), nameSuffix)),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -142,6 +150,19 @@ func TestAcc_DNSRecord(t *testing.T) {
 					resource.TestCheckResourceAttr("vercel_dns_record.ns", "ttl", "120"),
 					resource.TestCheckResourceAttr("vercel_dns_record.ns", "value", "example.com."),
 					resource.TestCheckResourceAttr("vercel_dns_record.ns", "comment", "ns"),
+					testAccDNSRecordExists(testClient(t), "vercel_dns_record.txt_quoted", testTeam(t)),
+					resource.TestCheckResourceAttr("vercel_dns_record.txt_quoted", "value", "\"terraform\" \" testing\""),
+					testAccDNSRecordExists(testClient(t), "vercel_dns_record.caa_block", testTeam(t)),
+					resource.TestCheckResourceAttr("vercel_dns_record.caa_block", "type", "CAA"),
+					resource.TestCheckNoResourceAttr("vercel_dns_record.caa_block", "value"),
+					resource.TestCheckResourceAttr("vercel_dns_record.caa_block", "caa.flags", "0"),
+					resource.TestCheckResourceAttr("vercel_dns_record.caa_block", "caa.tag", "issuewild"),
+					resource.TestCheckResourceAttr("vercel_dns_record.caa_block", "caa.value", "letsencrypt.org"),
+					testAccDNSRecordExists(testClient(t), "vercel_dns_record.https", testTeam(t)),
+					resource.TestCheckResourceAttr("vercel_dns_record.https", "type", "HTTPS"),
+					resource.TestCheckResourceAttr("vercel_dns_record.https", "https.priority", "1"),
+					resource.TestCheckResourceAttr("vercel_dns_record.https", "https.target", "."),
+					resource.TestCheckResourceAttr("vercel_dns_record.https", "https.params", "alpn=h2,h3"),
 				),
 			},
 			{
@@ -200,6 +221,13 @@ func TestAcc_DNSRecord(t *testing.T) {
 					resource.TestCheckResourceAttr("vercel_dns_record.ns", "type", "NS"),
 					resource.TestCheckResourceAttr("vercel_dns_record.ns", "ttl", "60"),
 					resource.TestCheckResourceAttr("vercel_dns_record.ns", "value", "example2.com."),
+					testAccDNSRecordExists(testClient(t), "vercel_dns_record.caa_block", testTeam(t)),
+					resource.TestCheckResourceAttr("vercel_dns_record.caa_block", "caa.flags", "128"),
+					resource.TestCheckResourceAttr("vercel_dns_record.caa_block", "caa.tag", "issue"),
+					testAccDNSRecordExists(testClient(t), "vercel_dns_record.https", testTeam(t)),
+					resource.TestCheckResourceAttr("vercel_dns_record.https", "https.priority", "2"),
+					resource.TestCheckResourceAttr("vercel_dns_record.https", "https.target", "example2.com."),
+					resource.TestCheckResourceAttr("vercel_dns_record.https", "https.params", "alpn=h3"),
 				),
 			},
 		},
@@ -306,6 +334,35 @@ resource "vercel_dns_record" "ns" {
   value = "example.com."
   comment = "ns"
 }
+resource "vercel_dns_record" "txt_quoted" {
+  domain = "%[1]s"
+  name   = "test-acc-%[2]s-txt-quoted"
+  type   = "TXT"
+  ttl    = 120
+  value  = "\"terraform\" \" testing\""
+}
+resource "vercel_dns_record" "caa_block" {
+  domain = "%[1]s"
+  name   = "test-acc-%[2]s-caa-block"
+  type   = "CAA"
+  ttl    = 120
+  caa = {
+    flags = 0
+    tag   = "issuewild"
+    value = "letsencrypt.org"
+  }
+}
+resource "vercel_dns_record" "https" {
+  domain = "%[1]s"
+  name   = "test-acc-%[2]s-https"
+  type   = "HTTPS"
+  ttl    = 120
+  https = {
+    priority = 1
+    target   = "."
+    params   = "alpn=h2,h3"
+  }
+}
 `, testDomain, nameSuffix)
 }
 
@@ -386,5 +443,45 @@ resource "vercel_dns_record" "ns" {
   ttl  = 60
   value = "example2.com."
 }
+resource "vercel_dns_record" "txt_quoted" {
+  domain = "%[1]s"
+  name   = "test-acc-%[2]s-txt-quoted"
+  type   = "TXT"
+  ttl    = 120
+  value  = "\"terraform\" \" testing\""
+}
+resource "vercel_dns_record" "caa_block" {
+  domain = "%[1]s"
+  name   = "test-acc-%[2]s-caa-block"
+  type   = "CAA"
+  ttl    = 120
+  caa = {
+    flags = 128
+    tag   = "issue"
+    value = "letsencrypt.org"
+  }
+}
+resource "vercel_dns_record" "https" {
+  domain = "%[1]s"
+  name   = "test-acc-%[2]s-https"
+  type   = "HTTPS"
+  ttl    = 120
+  https = {
+    priority = 2
+    target   = "example2.com."
+    params   = "alpn=h3"
+  }
+}
+`, testDomain, nameSuffix)
+}
+
+func testAccDNSRecordConfigInvalidValue(testDomain, nameSuffix string) string {
+	return fmt.Sprintf(`
+resource "vercel_dns_record" "a" {
+  domain = "%[1]s"
+  name   = "test-acc-%[2]s-invalid"
+  type   = "A"
+  value  = "::1"
+}
 `, testDomain, nameSuffix)
 }
@@ -0,0 +1,820 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+var (
+	_ resource.Resource                   = &dnsZoneResource{}
+	_ resource.ResourceWithConfigure      = &dnsZoneResource{}
+	_ resource.ResourceWithImportState    = &dnsZoneResource{}
+	_ resource.ResourceWithValidateConfig = &dnsZoneResource{}
+)
+
+func newDNSZoneResource() resource.Resource {
+	return &dnsZoneResource{}
+}
+
+type dnsZoneResource struct {
+	client *client.Client
+}
+
+func (r *dnsZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone"
+}
+
+func (r *dnsZoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema returns the schema information for a DNS zone resource.
+func (r *dnsZoneResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a DNS Zone resource, which manages a set of DNS records for a domain.
+
+The records can be configured either with ` + "`records`" + `, or with an RFC 1035 zone file in ` + "`zone_file`" + `. When applying, the records are compared to the existing records for the domain, and only the records that differ are created, updated or deleted.
+
+By default, only records created or adopted by this resource are managed, and other records for the domain are left untouched. An existing record that exactly matches a configured record is adopted rather than duplicated. With ` + "`exclusive`" + ` set, every record for the domain that is not configured is deleted, apart from the default records Vercel manages itself.
+
+~> A record should not be managed by both a ` + "`vercel_dns_zone`" + ` and a ` + "`vercel_dns_record`" + `.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/concepts/projects/custom-domains#dns-records)
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The team ID that the domain and DNS records belong to. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseNonNullStateForUnknown()},
+			},
+			"domain": schema.StringAttribute{
+				Description:   "The domain name, or zone, that the DNS records belong to.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:      true,
+			},
+			"records": schema.SetNestedAttribute{
+				Description: "The DNS records for the domain. Conflicts with `zone_file`.",
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ExactlyOneOf(path.MatchRoot("zone_file")),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The subdomain name of the record. This should be an empty string if the record is for the root domain.",
+							Required:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of DNS record. Available types: `A`, `AAAA`, `ALIAS`, `CAA`, `CNAME`, `HTTPS`, `MX`, `NS`, `SRV`, `TXT`.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(dnsZoneRecordTypes...),
+							},
+						},
+						"value": schema.StringAttribute{
+							Description: "The value of the DNS record. Required for all record types except `SRV` and `HTTPS`.",
+							Optional:    true,
+						},
+						"ttl": schema.Int64Attribute{
+							Description: "The TTL value in seconds. Must be a number between 60 and 2147483647. If unspecified, it will default to 60 seconds.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(60),
+								int64validator.AtMost(2147483647),
+							},
+						},
+						"mx_priority": schema.Int64Attribute{
+							Description: "The priority of the MX record. Required for `MX` records.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+								int64validator.AtMost(65535),
+							},
+						},
+						"comment": schema.StringAttribute{
+							Description: "A comment explaining what the DNS record is for.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(0, 500),
+							},
+						},
+						"srv": schema.SingleNestedAttribute{
+							Description: "Settings for an SRV record. Required for `SRV` records.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"weight": schema.Int64Attribute{
+									Description: "A relative weight for records with the same priority, higher value means higher chance of getting picked.",
+									Required:    true,
+								},
+								"port": schema.Int64Attribute{
+									Description: "The TCP or UDP port on which the service is to be found.",
+									Required:    true,
+								},
+								"priority": schema.Int64Attribute{
+									Description: "The priority of the target host, lower value means more preferred.",
+									Required:    true,
+								},
+								"target": schema.StringAttribute{
+									Description: "The canonical hostname of the machine providing the service.",
+									Required:    true,
+								},
+							},
+						},
+						"https": schema.SingleNestedAttribute{
+							Description: "Settings for an HTTPS record. Required for `HTTPS` records.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"priority": schema.Int64Attribute{
+									Description: "The priority of the record. `0` makes the record an alias for the target, and any other value gives the preference of the record, lower values being more preferred.",
+									Required:    true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(65535),
+									},
+								},
+								"target": schema.StringAttribute{
+									Description: "The hostname of the alternative endpoint, or `.` to use the name of the record itself.",
+									Required:    true,
+								},
+								"params": schema.StringAttribute{
+									Description: "The service parameters of the record, separated by spaces, such as `alpn=h2,h3 ipv4hint=76.76.21.21`.",
+									Optional:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"zone_file": schema.StringAttribute{
+				Description: "The DNS records for the domain, as an RFC 1035 zone file. The `$ORIGIN` and `$TTL` directives are supported, and `SOA` records are ignored. A comment on the same line as a record is used as the record's comment. Conflicts with `records`.",
+				Optional:    true,
+			},
+			"exclusive": schema.BoolAttribute{
+				Description: "Whether to delete any records for the domain that are not configured. Defaults to `false`. Records created by Vercel are never deleted.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"record_ids": schema.MapAttribute{
+				Description: "The IDs of the managed records, keyed by the name, type and value of each record.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+var dnsZoneRecordAttrType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":        types.StringType,
+		"type":        types.StringType,
+		"value":       types.StringType,
+		"ttl":         types.Int64Type,
+		"mx_priority": types.Int64Type,
+		"comment":     types.StringType,
+		"srv":         srvAttrType,
+		"https":       httpsAttrType,
+	},
+}
+
+// DNSZoneRecord reflects the state terraform stores internally for a record within a DNS zone.
+type DNSZoneRecord struct {
+	Name       types.String `tfsdk:"name"`
+	Type       types.String `tfsdk:"type"`
+	Value      types.String `tfsdk:"value"`
+	TTL        types.Int64  `tfsdk:"ttl"`
+	MXPriority types.Int64  `tfsdk:"mx_priority"`
+	Comment    types.String `tfsdk:"comment"`
+	SRV        types.Object `tfsdk:"srv"`
+	HTTPS      types.Object `tfsdk:"https"`
+}
+
+// DNSZone reflects the state terraform stores internally for a DNS zone.
+type DNSZone struct {
+	ID        types.String `tfsdk:"id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	Domain    types.String `tfsdk:"domain"`
+	Records   types.Set    `tfsdk:"records"`
+	ZoneFile  types.String `tfsdk:"zone_file"`
+	Exclusive types.Bool   `tfsdk:"exclusive"`
+	RecordIDs types.Map    `tfsdk:"record_ids"`
+}
+
+func (r DNSZoneRecord) toDNSZoneRecord() dnsZoneRecord {
+	record := dnsZoneRecord{
+		Name:       r.Name.ValueString(),
+		Type:       r.Type.ValueString(),
+		Value:      r.Value.ValueString(),
+		TTL:        r.TTL.ValueInt64(),
+		MXPriority: r.MXPriority.ValueInt64(),
+		Comment:    r.Comment.ValueString(),
+	}
+	if !r.SRV.IsNull() && !r.SRV.IsUnknown() {
+		var s SRV
+		_ = r.SRV.As(context.Background(), &s, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
+		record.SRV = &client.SRV{
+			Port:     s.Port.ValueInt64(),
+			Priority: s.Priority.ValueInt64(),
+			Target:   s.Target.ValueString(),
+			Weight:   s.Weight.ValueInt64(),
+		}
+	}
+	if !r.HTTPS.IsNull() && !r.HTTPS.IsUnknown() {
+		var h HTTPS
+		_ = r.HTTPS.As(context.Background(), &h, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
+		record.HTTPS = &client.HTTPS{
+			Priority: h.Priority.ValueInt64(),
+			Target:   h.Target.ValueString(),
+			Params:   h.Params.ValueString(),
+		}
+	}
+	return record.normalise()
+}
+
+func convertDNSZoneRecordToValue(r dnsZoneRecord) attr.Value {
+	value := types.StringValue(r.Value)
+	mxPriority := types.Int64Null()
+	srv := types.ObjectNull(srvAttrType.AttrTypes)
+	https := types.ObjectNull(httpsAttrType.AttrTypes)
+	switch r.Type {
+	case "MX":
+		mxPriority = types.Int64Value(r.MXPriority)
+	case "SRV":
+		value = types.StringNull()
+		srv = types.ObjectValueMust(srvAttrType.AttrTypes, map[string]attr.Value{
+			"port":     types.Int64Value(r.SRV.Port),
+			"priority": types.Int64Value(r.SRV.Priority),
+			"target":   types.StringValue(r.SRV.Target),
+			"weight":   types.Int64Value(r.SRV.Weight),
+		})
+	case "HTTPS":
+		value = types.StringNull()
+		params := types.StringNull()
+		if r.HTTPS.Params != "" {
+			params = types.StringValue(r.HTTPS.Params)
+		}
+		https = types.ObjectValueMust(httpsAttrType.AttrTypes, map[string]attr.Value{
+			"priority": types.Int64Value(r.HTTPS.Priority),
+			"target":   types.StringValue(r.HTTPS.Target),
+			"params":   params,
+		})
+	}
+	ttl := types.Int64Null()
+	if r.TTL != 0 {
+		ttl = types.Int64Value(r.TTL)
+	}
+	comment := types.StringNull()
+	if r.Comment != "" {
+		comment = types.StringValue(r.Comment)
+	}
+	return types.ObjectValueMust(dnsZoneRecordAttrType.AttrTypes, map[string]attr.Value{
+		"name":        types.StringValue(r.Name),
+		"type":        types.StringValue(r.Type),
+		"value":       value,
+		"ttl":         ttl,
+		"mx_priority": mxPriority,
+		"comment":     comment,
+		"srv":         srv,
+		"https":       https,
+	})
+}
+
+// desiredRecords returns the configured records, from either the records or the zone file.
+func (z DNSZone) desiredRecords(ctx context.Context) ([]dnsZoneRecord, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !z.ZoneFile.IsNull() {
+		records, err := parseZoneFile(z.ZoneFile.ValueString(), z.Domain.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("zone_file"),
+				"Invalid zone file",
+				fmt.Sprintf("The zone file could not be parsed: %s", err),
+			)
+		}
+		return records, diags
+	}
+
+	var models []DNSZoneRecord
+	diags.Append(z.Records.ElementsAs(ctx, &models, false)...)
+	var records []dnsZoneRecord
+	for _, m := range models {
+		records = append(records, m.toDNSZoneRecord())
+	}
+	return records, diags
+}
+
+func (r *dnsZoneResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DNSZone
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.Domain.IsUnknown() || config.ZoneFile.IsUnknown() || config.Records.IsUnknown() {
+		return
+	}
+
+	if config.ZoneFile.IsNull() {
+		var models []DNSZoneRecord
+		resp.Diagnostics.Append(config.Records.ElementsAs(ctx, &models, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, m := range models {
+			if m.Type.IsUnknown() || m.Value.IsUnknown() || m.SRV.IsUnknown() || m.HTTPS.IsUnknown() || m.MXPriority.IsUnknown() {
+				continue
+			}
+			recordType := m.Type.ValueString()
+			switch {
+			case recordType == "SRV" && m.SRV.IsNull():
+				resp.Diagnostics.AddAttributeError(path.Root("records"), "DNS Zone Invalid", "A DNS Record type of 'SRV' requires the `srv` attribute to be set")
+			case recordType == "HTTPS" && m.HTTPS.IsNull():
+				resp.Diagnostics.AddAttributeError(path.Root("records"), "DNS Zone Invalid", "A DNS Record type of 'HTTPS' requires the `https` attribute to be set")
+			case recordType != "SRV" && recordType != "HTTPS" && m.Value.IsNull():
+				resp.Diagnostics.AddAttributeError(path.Root("records"), "DNS Zone Invalid", fmt.Sprintf("The `value` attribute must be set on records of `type` '%s'", recordType))
+			case (recordType == "SRV" || recordType == "HTTPS") && !m.Value.IsNull():
+				resp.Diagnostics.AddAttributeError(path.Root("records"), "DNS Zone Invalid", fmt.Sprintf("The `value` attribute should not be set on records of `type` '%s'", recordType))
+			case recordType != "SRV" && !m.SRV.IsNull():
+				resp.Diagnostics.AddAttributeError(path.Root("records"), "DNS Zone Invalid", "The `srv` attribute should only be set on records of `type` 'SRV'")
+			case recordType != "HTTPS" && !m.HTTPS.IsNull():
+				resp.Diagnostics.AddAttributeError(path.Root("records"), "DNS Zone Invalid", "The `https` attribute should only be set on records of `type` 'HTTPS'")
+			case recordType == "MX" && m.MXPriority.IsNull():
+				resp.Diagnostics.AddAttributeError(path.Root("records"), "DNS Zone Invalid", "A DNS Record type of 'MX' requires the `mx_priority` attribute to be set")
+			case recordType != "MX" && !m.MXPriority.IsNull():
+				resp.Diagnostics.AddAttributeError(path.Root("records"), "DNS Zone Invalid", "The `mx_priority` attribute should only be set on records of `type` 'MX'")
+			}
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	records, diags := config.desiredRecords(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	seen := map[string]bool{}
+	for _, record := range records {
+		switch record.Type {
+		case "SRV":
+		case "HTTPS":
+			if record.HTTPS != nil && record.HTTPS.Target != "." && validateDNSRecordValue("CNAME", record.HTTPS.Target) != nil {
+				resp.Diagnostics.AddError(
+					"DNS Zone Invalid",
+					fmt.Sprintf("The record %q has an invalid target: it must be a hostname or `.`, but got %s", record.key(), record.HTTPS.Target),
+				)
+				continue
+			}
+		default:
+			if err := validateDNSRecordValue(record.Type, record.Value); err != nil {
+				resp.Diagnostics.AddError(
+					"DNS Zone Invalid",
+					fmt.Sprintf("The record %q has an invalid value: %s", record.key(), err),
+				)
+				continue
+			}
+		}
+		if seen[record.key()] {
+			resp.Diagnostics.AddError(
+				"DNS Zone Invalid",
+				fmt.Sprintf("The record %q is configured more than once.", record.key()),
+			)
+		}
+		seen[record.key()] = true
+	}
+}
+
+// listDNSZoneRecords lists the records for a domain, excluding the default records created by Vercel.
+func listDNSZoneRecords(ctx context.Context, c *client.Client, domain, teamID string) ([]dnsZoneRecord, error) {
+	out, err := c.ListDNSRecords(ctx, domain, teamID)
+	if err != nil {
+		return nil, err
+	}
+	var records []dnsZoneRecord
+	for _, o := range out {
+		if o.Creator == "system" {
+			continue
+		}
+		record, err := dnsZoneRecordFromResponse(o)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse record %s: %w", o.ID, err)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// managedRecordIDs returns the IDs of the records in the state's record_ids.
+func (z DNSZone) managedRecordIDs() map[string]bool {
+	managed := map[string]bool{}
+	for _, v := range z.RecordIDs.Elements() {
+		if s, ok := v.(types.String); ok {
+			managed[s.ValueString()] = true
+		}
+	}
+	return managed
+}
+
+// apply compares the desired records with the existing records for the domain, and creates, updates and
+// deletes records so that they match. It returns the IDs of the resulting records, keyed by record.
+func (r *dnsZoneResource) apply(ctx context.Context, plan DNSZone, managed map[string]bool) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	domain, teamID := plan.Domain.ValueString(), plan.TeamID.ValueString()
+	desired, d := plan.desiredRecords(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	current, err := listDNSZoneRecords(ctx, r.client, domain, teamID)
+	if err != nil {
+		diags.AddError(
+			"Error reading DNS records",
+			fmt.Sprintf("Could not list DNS records for %s, unexpected error: %s", domain, err),
+		)
+		return nil, diags
+	}
+	exclusive := plan.Exclusive.ValueBool()
+	isCandidate := func(c dnsZoneRecord) bool {
+		return exclusive || managed[c.ID]
+	}
+
+	ids := map[string]string{}
+	used := map[string]bool{}
+	var unmatched []dnsZoneRecord
+	// Records that already exist exactly as configured are kept, or adopted if they are not yet managed.
+	for _, want := range desired {
+		found := false
+		for _, c := range current {
+			if !used[c.ID] && want.matches(c) {
+				ids[want.key()] = c.ID
+				used[c.ID] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			unmatched = append(unmatched, want)
+		}
+	}
+
+	// Managed records with the same name and type as a configured record are updated in place,
+	// preferring a record that only differs by TTL or comment.
+	var updates, creates []dnsZoneRecord
+	for _, want := range unmatched {
+		match := -1
+		for i, c := range current {
+			if used[c.ID] || !isCandidate(c) || c.Name != want.Name || c.Type != want.Type {
+				continue
+			}
+			if match == -1 || c.key() == want.key() {
+				match = i
+			}
+		}
+		if match == -1 {
+			creates = append(creates, want)
+			continue
+		}
+		used[current[match].ID] = true
+		want.ID = current[match].ID
+		updates = append(updates, want)
+	}
+
+	var deletes []dnsZoneRecord
+	for _, c := range current {
+		if !used[c.ID] && isCandidate(c) {
+			deletes = append(deletes, c)
+		}
+	}
+	tflog.Info(ctx, "applying dns zone", map[string]any{
+		"domain":  domain,
+		"creates": len(creates),
+		"updates": len(updates),
+		"deletes": len(deletes),
+	})
+
+	// If a request fails, the previously managed records that have not been deleted or updated yet are
+	// kept in the result, as they are, so that they are still managed and can be cleaned up next time.
+	deleted := map[string]bool{}
+	withUnprocessed := func() map[string]string {
+		managedIDs := map[string]bool{}
+		for _, id := range ids {
+			managedIDs[id] = true
+		}
+		for _, c := range current {
+			if !managed[c.ID] || deleted[c.ID] || managedIDs[c.ID] {
+				continue
+			}
+			if _, ok := ids[c.key()]; !ok {
+				ids[c.key()] = c.ID
+			}
+		}
+		return ids
+	}
+
+	// Deleting first avoids conflicts, such as a CNAME record being created for a name that has other
+	// records being removed.
+	for _, c := range deletes {
+		err := r.client.DeleteDNSRecord(ctx, domain, c.ID, teamID)
+		if err != nil && !client.NotFound(err) {
+			diags.AddError(
+				"Error deleting DNS record",
+				fmt.Sprintf("Could not delete DNS record %q (%s), unexpected error: %s", c.key(), c.ID, err),
+			)
+			return withUnprocessed(), diags
+		}
+		deleted[c.ID] = true
+	}
+	for _, u := range updates {
+		_, err := r.client.UpdateDNSRecord(ctx, teamID, u.ID, u.toUpdateRequest())
+		if err != nil {
+			diags.AddError(
+				"Error updating DNS record",
+				fmt.Sprintf("Could not update DNS record %s to %q, unexpected error: %s", u.ID, u.key(), err),
+			)
+			return withUnprocessed(), diags
+		}
+		ids[u.key()] = u.ID
+	}
+	for _, c := range creates {
+		out, err := r.client.CreateDNSRecord(ctx, teamID, c.toCreateRequest(domain))
+		if err != nil {
+			diags.AddError(
+				"Error creating DNS record",
+				fmt.Sprintf("Could not create DNS record %q, unexpected error: %s", c.key(), err),
+			)
+			return withUnprocessed(), diags
+		}
+		ids[c.key()] = out.ID
+	}
+	return ids, diags
+}
+
+func toRecordIDs(ids map[string]string) types.Map {
+	elements := map[string]attr.Value{}
+	for k, v := range ids {
+		elements[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// Create will create any DNS records for the domain that do not already exist, and, if exclusive, delete
+// any that are not configured.
+// This is called automatically by the provider when a new resource should be created.
+func (r *dnsZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DNSZone
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, diags := r.apply(ctx, plan, map[string]bool{})
+	resp.Diagnostics.Append(diags...)
+	if ids == nil {
+		return
+	}
+
+	// The state is saved even if some records could not be created, so that the records which were
+	// created are managed.
+	result := plan
+	result.ID = plan.Domain
+	result.TeamID = toTeamID(r.client.TeamID(plan.TeamID.ValueString()))
+	result.RecordIDs = toRecordIDs(ids)
+	tflog.Info(ctx, "created dns zone", map[string]any{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Domain.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read will read the DNS records for the domain by requesting them from the Vercel API. If the managed
+// records no longer match the configured records, the state is updated to reflect the records that exist.
+func (r *dnsZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DNSZone
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := listDNSZoneRecords(ctx, r.client, state.Domain.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNS records",
+			fmt.Sprintf("Could not list DNS records for %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.Domain.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	desired, diags := state.desiredRecords(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed := state.managedRecordIDs()
+	var actual []dnsZoneRecord
+	for _, c := range current {
+		if state.Exclusive.ValueBool() || managed[c.ID] {
+			actual = append(actual, c)
+		}
+	}
+
+	ids := map[string]string{}
+	used := map[string]bool{}
+	inSync := len(actual) == len(desired)
+	for _, want := range desired {
+		found := false
+		for _, c := range actual {
+			if !used[c.ID] && want.matches(c) {
+				ids[want.key()] = c.ID
+				used[c.ID] = true
+				found = true
+				break
+			}
+		}
+		inSync = inSync && found
+	}
+
+	if !inSync {
+		tflog.Info(ctx, "dns zone has drifted", map[string]any{
+			"domain": state.Domain.ValueString(),
+		})
+		ids = map[string]string{}
+		values := []attr.Value{}
+		for _, c := range actual {
+			ids[c.key()] = c.ID
+			values = append(values, convertDNSZoneRecordToValue(c))
+		}
+		if state.ZoneFile.IsNull() {
+			state.Records = types.SetValueMust(dnsZoneRecordAttrType, values)
+		} else {
+			state.ZoneFile = types.StringValue(renderZoneFile(state.Domain.ValueString(), actual))
+		}
+	}
+	state.RecordIDs = toRecordIDs(ids)
+	tflog.Info(ctx, "read dns zone", map[string]any{
+		"team_id": state.TeamID.ValueString(),
+		"domain":  state.Domain.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update will create, update and delete DNS records so that the records for the domain match the
+// configured records.
+func (r *dnsZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state DNSZone
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, diags := r.apply(ctx, plan, state.managedRecordIDs())
+	resp.Diagnostics.Append(diags...)
+	if ids == nil {
+		return
+	}
+
+	result := plan
+	result.RecordIDs = toRecordIDs(ids)
+	tflog.Info(ctx, "updated dns zone", map[string]any{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Domain.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the DNS records managed by the zone.
+func (r *dnsZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DNSZone
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for id := range state.managedRecordIDs() {
+		err := r.client.DeleteDNSRecord(ctx, state.Domain.ValueString(), id, state.TeamID.ValueString())
+		if client.NotFound(err) {
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting DNS record",
+				fmt.Sprintf(
+					"Could not delete DNS record %s %s, unexpected error: %s",
+					state.Domain.ValueString(),
+					id,
+					err,
+				),
+			)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "deleted dns zone", map[string]any{
+		"team_id": state.TeamID.ValueString(),
+		"domain":  state.Domain.ValueString(),
+	})
+}
+
+// ImportState takes a domain and reads all its DNS records from the Vercel API, apart from the default
+// records created by Vercel. The records are imported into `records`.
+func (r *dnsZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, domain, ok := splitInto1Or2(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing DNS Zone",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/domain\" or \"domain\"", req.ID),
+		)
+		return
+	}
+
+	current, err := listDNSZoneRecords(ctx, r.client, domain, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNS records",
+			fmt.Sprintf("Could not list DNS records for %s %s, unexpected error: %s", teamID, domain, err),
+		)
+		return
+	}
+
+	ids := map[string]string{}
+	values := []attr.Value{}
+	for _, c := range current {
+		ids[c.key()] = c.ID
+		values = append(values, convertDNSZoneRecordToValue(c))
+	}
+	result := DNSZone{
+		ID:        types.StringValue(domain),
+		TeamID:    toTeamID(r.client.TeamID(teamID)),
+		Domain:    types.StringValue(domain),
+		Records:   types.SetValueMust(dnsZoneRecordAttrType, values),
+		ZoneFile:  types.StringNull(),
+		Exclusive: types.BoolValue(false),
+		RecordIDs: toRecordIDs(ids),
+	}
+	tflog.Info(ctx, "imported dns zone", map[string]any{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  domain,
+		"records": len(current),
+	})
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
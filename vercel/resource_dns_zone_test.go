@@ -0,0 +1,216 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+func testAccDNSZoneRecordsDestroyed(testClient *client.Client, domain, teamID, nameSuffix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		records, err := testClient.ListDNSRecords(context.TODO(), domain, teamID)
+		if err != nil {
+			return err
+		}
+		for _, r := range records {
+			if regexp.MustCompile(nameSuffix + "$").MatchString(r.Name) {
+				return fmt.Errorf("expected dns record %s %s to have been deleted", r.Name, r.RecordType)
+			}
+		}
+		return nil
+	}
+}
+
+func TestAcc_DNSZone(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	domain := testDomain(t)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccDNSZoneRecordsDestroyed(testClient(t), domain, testTeam(t), nameSuffix),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_dns_zone" "test" {
+  domain = "%[1]s"
+  zone_file = <<EOT
+$ORIGIN %[1]s.
+www-%[2]s  IN  A  1.1.1.1
+www-%[2]s  IN  TXT  "hello"
+EOT
+  records = []
+}
+`, domain, nameSuffix)),
+				ExpectError: regexp.MustCompile(`one\s+\(and\s+only\s+one\)`),
+			},
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_dns_zone" "test" {
+  domain = "%[1]s"
+  zone_file = <<EOT
+other.example.org.  IN  A  1.1.1.1
+EOT
+}
+`, domain)),
+				ExpectError: regexp.MustCompile(`is\s+not\s+within`),
+			},
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_dns_zone" "test" {
+  domain = "%[1]s"
+  records = [
+    {
+      name  = "a-%[2]s"
+      type  = "A"
+      value = "1.1.1.1"
+      ttl   = 120
+    },
+    {
+      name  = "cname-%[2]s"
+      type  = "CNAME"
+      value = "example.com"
+    },
+    {
+      name        = "mx-%[2]s"
+      type        = "MX"
+      value       = "mail.example.com"
+      mx_priority = 10
+    },
+    {
+      name = "https-%[2]s"
+      type = "HTTPS"
+      https = {
+        priority = 1
+        target   = "."
+        params   = "alpn=h2,h3"
+      }
+    },
+  ]
+}
+`, domain, nameSuffix)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_dns_zone.test", "records.#", "4"),
+					resource.TestCheckResourceAttr("vercel_dns_zone.test", "record_ids.%", "4"),
+					resource.TestCheckResourceAttrSet("vercel_dns_zone.test", fmt.Sprintf("record_ids.a-%s A 1.1.1.1", nameSuffix)),
+					resource.TestCheckResourceAttrSet("vercel_dns_zone.test", fmt.Sprintf("record_ids.https-%s HTTPS 1 . alpn=h2,h3", nameSuffix)),
+				),
+			},
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_dns_zone" "test" {
+  domain = "%[1]s"
+  zone_file = <<EOT
+$ORIGIN %[1]s.
+$TTL 120
+a-%[2]s      IN  A      2.2.2.2
+cname-%[2]s  IN  CNAME  example.com. ; the example
+https-%[2]s  IN  HTTPS  1  example.com.  alpn=h2
+EOT
+}
+
+data "vercel_dns_zone" "test" {
+  domain     = "%[1]s"
+  depends_on = [vercel_dns_zone.test]
+}
+`, domain, nameSuffix)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_dns_zone.test", "record_ids.%", "3"),
+					resource.TestCheckResourceAttrSet("vercel_dns_zone.test", fmt.Sprintf("record_ids.a-%s A 2.2.2.2", nameSuffix)),
+					resource.TestCheckResourceAttrSet("vercel_dns_zone.test", fmt.Sprintf("record_ids.cname-%s CNAME example.com", nameSuffix)),
+					resource.TestMatchResourceAttr("data.vercel_dns_zone.test", "zone_file", regexp.MustCompile(fmt.Sprintf(`a-%s\t120\tIN\tA\t2\.2\.2\.2`, nameSuffix))),
+					resource.TestMatchResourceAttr("data.vercel_dns_zone.test", "zone_file", regexp.MustCompile(fmt.Sprintf(`cname-%s\t120\tIN\tCNAME\texample\.com\. ; the example`, nameSuffix))),
+					resource.TestMatchResourceAttr("data.vercel_dns_zone.test", "zone_file", regexp.MustCompile(fmt.Sprintf(`https-%s\t120\tIN\tHTTPS\t1 example\.com\. alpn=h2`, nameSuffix))),
+				),
+			},
+		},
+	})
+}
+
+func testAccDNSZoneCreateUnmanagedRecord(testClient *client.Client, domain, teamID, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := testClient.CreateDNSRecord(context.TODO(), teamID, client.CreateDNSRecordRequest{
+			Domain: domain,
+			Name:   name,
+			Type:   "TXT",
+			Value:  "unmanaged",
+		})
+		if err != nil {
+			return fmt.Errorf("error creating dns record %s: %w", name, err)
+		}
+		return nil
+	}
+}
+
+func testAccDNSZoneRecordExists(testClient *client.Client, domain, teamID, name string, want bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		records, err := testClient.ListDNSRecords(context.TODO(), domain, teamID)
+		if err != nil {
+			return err
+		}
+		exists := false
+		for _, r := range records {
+			if r.Name == name {
+				exists = true
+			}
+		}
+		if exists != want {
+			if want {
+				return fmt.Errorf("expected dns record %s to exist", name)
+			}
+			return fmt.Errorf("expected dns record %s to have been deleted", name)
+		}
+		return nil
+	}
+}
+
+func TestAcc_DNSZoneExclusive(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	domain := testExclusiveDomain(t)
+	unmanaged := "unmanaged-" + nameSuffix
+	config := cfg(fmt.Sprintf(`
+resource "vercel_dns_zone" "test" {
+  domain    = "%[1]s"
+  exclusive = true
+  records = [
+    {
+      name  = "a-%[2]s"
+      type  = "A"
+      value = "1.1.1.1"
+    },
+  ]
+}
+`, domain, nameSuffix))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccDNSZoneRecordsDestroyed(testClient(t), domain, testTeam(t), nameSuffix),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_dns_zone.test", "record_ids.%", "1"),
+					// Add a record outside of Terraform, which should be detected as drift.
+					testAccDNSZoneCreateUnmanagedRecord(testClient(t), domain, testTeam(t), unmanaged),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_dns_zone.test", "record_ids.%", "1"),
+					testAccDNSZoneRecordExists(testClient(t), domain, testTeam(t), unmanaged, false),
+					testAccDNSZoneRecordExists(testClient(t), domain, testTeam(t), "a-"+nameSuffix, true),
+				),
+			},
+		},
+	})
+}
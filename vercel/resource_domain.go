@@ -0,0 +1,424 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+var (
+	_ resource.Resource                = &domainResource{}
+	_ resource.ResourceWithConfigure   = &domainResource{}
+	_ resource.ResourceWithImportState = &domainResource{}
+)
+
+func newDomainResource() resource.Resource {
+	return &domainResource{}
+}
+
+type domainResource struct {
+	client *client.Client
+}
+
+func (r *domainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain"
+}
+
+func (r *domainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+var domainVerificationRecordAttrType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"type":   types.StringType,
+		"domain": types.StringType,
+		"value":  types.StringType,
+	},
+}
+
+// Schema returns the schema information for a domain resource.
+func (r *domainResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Domain resource.
+
+A Domain is an apex domain, such as ` + "`example.com`" + `, that has been added to a team. Once added, the domain and its subdomains can be assigned to projects with ` + "`vercel_project_domain`" + `, and if the domain uses Vercel DNS, its records can be managed with ` + "`vercel_dns_record`" + `.
+
+A domain must be verified before it can be used. It is verified either by pointing its nameservers at the ` + "`intended_nameservers`" + `, or by adding the ` + "`verification_records`" + ` to its DNS.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/domains/working-with-domains/add-a-domain).
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:   "The ID of the Domain.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Description:   "The domain name, such as `example.com`.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"team_id": schema.StringAttribute{
+				Description:   "The team ID to add the domain to. Required when configuring a team resource if a default team has not been set in the provider.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseNonNullStateForUnknown()},
+			},
+			"verified": schema.BoolAttribute{
+				Description: "Whether the domain has been verified.",
+				Computed:    true,
+			},
+			"uses_vercel_dns": schema.BoolAttribute{
+				Description: "Whether the domain's DNS is served by Vercel.",
+				Computed:    true,
+			},
+			"service_type": schema.StringAttribute{
+				Description: "How the domain's DNS is served. One of `zeit.world` (Vercel DNS), `external` or `na`.",
+				Computed:    true,
+			},
+			"nameservers": schema.ListAttribute{
+				Description: "The nameservers the domain currently uses.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"intended_nameservers": schema.ListAttribute{
+				Description: "The Vercel nameservers the domain should use for its DNS to be served by Vercel.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"custom_nameservers": schema.ListAttribute{
+				Description: "Any custom nameservers configured for the domain.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"verification_records": schema.ListNestedAttribute{
+				Description: "The DNS records that verify ownership of the domain, for domains that do not use Vercel's nameservers.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "The type of the DNS record.",
+							Computed:    true,
+						},
+						"domain": schema.StringAttribute{
+							Description: "The name of the DNS record.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "The value of the DNS record.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"wait_for_verification": schema.BoolAttribute{
+				Description: "Whether to wait for the domain to be verified when it is added. If the domain is not verified within the `verification_timeout`, the apply fails and the resource is marked as tainted.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"verification_timeout": schema.StringAttribute{
+				Description: "How long to wait for the domain to be verified, such as `30m`. Defaults to `10m`. Only used if `wait_for_verification` is set.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("10m"),
+				Validators: []validator.String{
+					validateDuration(),
+				},
+			},
+		},
+	}
+}
+
+type Domain struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	TeamID              types.String `tfsdk:"team_id"`
+	Verified            types.Bool   `tfsdk:"verified"`
+	UsesVercelDNS       types.Bool   `tfsdk:"uses_vercel_dns"`
+	ServiceType         types.String `tfsdk:"service_type"`
+	Nameservers         types.List   `tfsdk:"nameservers"`
+	IntendedNameservers types.List   `tfsdk:"intended_nameservers"`
+	CustomNameservers   types.List   `tfsdk:"custom_nameservers"`
+	VerificationRecords types.List   `tfsdk:"verification_records"`
+	WaitForVerification types.Bool   `tfsdk:"wait_for_verification"`
+	VerificationTimeout types.String `tfsdk:"verification_timeout"`
+}
+
+func toStringList(values []string) types.List {
+	elements := []attr.Value{}
+	for _, v := range values {
+		elements = append(elements, types.StringValue(v))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func domainVerificationRecords(response client.DomainResponse) types.List {
+	records := []attr.Value{}
+	if response.VerificationRecord != "" {
+		records = append(records, types.ObjectValueMust(domainVerificationRecordAttrType.AttrTypes, map[string]attr.Value{
+			"type":   types.StringValue("TXT"),
+			"domain": types.StringValue("_vercel." + response.Name),
+			"value":  types.StringValue(response.VerificationRecord),
+		}))
+	}
+	return types.ListValueMust(domainVerificationRecordAttrType, records)
+}
+
+func convertResponseToDomain(response client.DomainResponse, plan Domain) Domain {
+	return Domain{
+		ID:                  types.StringValue(response.ID),
+		Name:                types.StringValue(response.Name),
+		TeamID:              toTeamID(response.TeamID),
+		Verified:            types.BoolValue(response.Verified),
+		UsesVercelDNS:       types.BoolValue(response.UsesVercelDNS()),
+		ServiceType:         types.StringValue(response.ServiceType),
+		Nameservers:         toStringList(response.Nameservers),
+		IntendedNameservers: toStringList(response.IntendedNameservers),
+		CustomNameservers:   toStringList(response.CustomNameservers),
+		VerificationRecords: domainVerificationRecords(response),
+		WaitForVerification: plan.WaitForVerification,
+		VerificationTimeout: plan.VerificationTimeout,
+	}
+}
+
+// waitForVerification polls the domain until it is verified, or until the verification_timeout elapses.
+func (r *domainResource) waitForVerification(ctx context.Context, plan Domain, out client.DomainResponse) (client.DomainResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !plan.WaitForVerification.ValueBool() || out.Verified {
+		return out, diags
+	}
+
+	timeout, _ := time.ParseDuration(plan.VerificationTimeout.ValueString())
+	tflog.Info(ctx, "waiting for domain verification", map[string]any{
+		"domain":  out.Name,
+		"timeout": timeout.String(),
+	})
+	name, teamID := out.Name, out.TeamID
+	err := pollUntil(ctx, timeout, 15*time.Second, func(ctx context.Context) (bool, error) {
+		latest, err := r.client.GetDomain(ctx, name, teamID)
+		if err != nil {
+			return false, err
+		}
+		out = latest
+		return out.Verified, nil
+	})
+	if err != nil {
+		diags.AddError(
+			"Error waiting for domain verification",
+			fmt.Sprintf(
+				"Domain %s was not verified: %s. Point its nameservers at %v, or add the verification records to its DNS.",
+				out.Name,
+				err,
+				out.IntendedNameservers,
+			),
+		)
+	}
+	return out, diags
+}
+
+// Create will add a domain to a team within Vercel, and optionally wait for it to be verified.
+// This is called automatically by the provider when a new resource should be created.
+func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Domain
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.CreateDomain(ctx, plan.Name.ValueString(), plan.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error adding domain",
+			"Could not add domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, "created domain", map[string]any{
+		"team_id": out.TeamID,
+		"domain":  out.Name,
+	})
+
+	// The state is set even if verification times out, so that the domain is removed again if it is tainted.
+	out, diags = r.waitForVerification(ctx, plan, out)
+	resp.Diagnostics.Append(diags...)
+
+	result := convertResponseToDomain(out, plan)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read will read the domain information by requesting it from the Vercel API, and will update terraform
+// with this information.
+func (r *domainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Domain
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetDomain(ctx, state.Name.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domain",
+			fmt.Sprintf("Could not get domain %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.Name.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result := convertResponseToDomain(out, state)
+	tflog.Info(ctx, "read domain", map[string]any{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Name.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only changes whether to wait for verification, as every other attribute requires replacement. If
+// waiting has been enabled and the domain is not yet verified, it waits for it to be verified.
+func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Domain
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetDomain(ctx, plan.Name.ValueString(), plan.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domain",
+			fmt.Sprintf("Could not get domain %s %s, unexpected error: %s",
+				plan.TeamID.ValueString(),
+				plan.Name.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	out, diags = r.waitForVerification(ctx, plan, out)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result := convertResponseToDomain(out, plan)
+	tflog.Info(ctx, "updated domain", map[string]any{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Name.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes a domain from a team.
+func (r *domainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Domain
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDomain(ctx, state.Name.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting domain",
+			fmt.Sprintf(
+				"Could not delete domain %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.Name.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "deleted domain", map[string]any{
+		"team_id": state.TeamID.ValueString(),
+		"domain":  state.Name.ValueString(),
+	})
+}
+
+// ImportState takes an identifier and reads all the domain information from the Vercel API.
+// The results are then stored in terraform state.
+func (r *domainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, name, ok := splitInto1Or2(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing domain",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/domain\" or \"domain\"", req.ID),
+		)
+		return
+	}
+
+	out, err := r.client.GetDomain(ctx, name, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domain",
+			fmt.Sprintf("Could not get domain %s %s, unexpected error: %s",
+				teamID,
+				name,
+				err,
+			),
+		)
+		return
+	}
+
+	result := convertResponseToDomain(out, Domain{
+		WaitForVerification: types.BoolValue(false),
+		VerificationTimeout: types.StringValue("10m"),
+	})
+	tflog.Info(ctx, "imported domain", map[string]any{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Name.ValueString(),
+	})
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,72 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+func testCheckDomainDeleted(testClient *client.Client, n, teamID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		_, err := testClient.GetDomain(context.TODO(), rs.Primary.Attributes["name"], teamID)
+		if err == nil {
+			return fmt.Errorf("expected not_found error, but got no error")
+		}
+		if !client.NotFound(err) {
+			return fmt.Errorf("Unexpected error checking for deleted domain: %s", err)
+		}
+
+		return nil
+	}
+}
+
+func TestAcc_DomainResource(t *testing.T) {
+	name := fmt.Sprintf("test-acc-%s.com", acctest.RandString(16))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckDomainDeleted(testClient(t), "vercel_domain.test", testTeam(t)),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_domain" "test" {
+  name = "%s"
+}
+`, name)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vercel_domain.test", "id"),
+					resource.TestCheckResourceAttr("vercel_domain.test", "name", name),
+					resource.TestCheckResourceAttr("vercel_domain.test", "verified", "false"),
+					resource.TestCheckResourceAttr("vercel_domain.test", "wait_for_verification", "false"),
+					resource.TestCheckResourceAttrSet("vercel_domain.test", "intended_nameservers.#"),
+				),
+			},
+			{
+				ResourceName:      "vercel_domain.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getDomainImportID("vercel_domain.test"),
+			},
+		},
+	})
+}
+
+func getDomainImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["team_id"], rs.Primary.Attributes["name"]), nil
+	}
+}
@@ -0,0 +1,565 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+var (
+	_ resource.Resource                     = &microfrontendRoutingResource{}
+	_ resource.ResourceWithConfigure        = &microfrontendRoutingResource{}
+	_ resource.ResourceWithConfigValidators = &microfrontendRoutingResource{}
+	_ resource.ResourceWithModifyPlan       = &microfrontendRoutingResource{}
+)
+
+func newMicrofrontendRoutingResource() resource.Resource {
+	return &microfrontendRoutingResource{}
+}
+
+type microfrontendRoutingResource struct {
+	client *client.Client
+}
+
+func (r *microfrontendRoutingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_microfrontend_routing"
+}
+
+func (r *microfrontendRoutingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema returns the schema information for a microfrontend routing resource.
+func (r *microfrontendRoutingResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Microfrontend Routing resource.
+
+The routing between the applications of a Microfrontend Group is configured by a ` + "`microfrontends.json`" + ` file, which is deployed with the default application. This resource generates that file from the routing configuration, and validates it against the Microfrontend Group:
+- paths must not overlap between applications.
+- the default application must be the default app of the group.
+- every application must be a member of the group.
+
+The generated file is available as the ` + "`json`" + ` attribute, so that it can be written into the default application's source or included in a ` + "`vercel_deployment`" + `.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/microfrontends/configuration).
+
+~> This resource does not change anything in Vercel. The routing only takes effect once ` + "`microfrontends.json`" + ` is deployed.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:   "The ID of the Microfrontend Group.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"microfrontend_group_id": schema.StringAttribute{
+				Description:   "The ID of the Microfrontend Group.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"team_id": schema.StringAttribute{
+				Description:   "The team ID the Microfrontend Group belongs to. Required when configuring a team resource if a default team has not been set in the provider.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseNonNullStateForUnknown()},
+			},
+			"default_app": schema.SingleNestedAttribute{
+				Description: "The default application, which serves every path not routed to another application.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"project_id": schema.StringAttribute{
+						Description: "The ID of the Project of the default application.",
+						Required:    true,
+					},
+					"fallback": schema.StringAttribute{
+						Description: "The host that requests are sent to during local development when the default application is not running locally, such as `example.vercel.app`.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+			"applications": schema.ListNestedAttribute{
+				Description: "The applications that serve specific paths.",
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"project_id": schema.StringAttribute{
+							Description: "The ID of the Project of the application.",
+							Required:    true,
+						},
+						"paths": schema.ListAttribute{
+							Description: "The paths served by the application, such as `/docs` or `/docs/:path*`.",
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueStringsAre(
+									stringvalidator.RegexMatches(
+										regexp.MustCompile(`^(/|(/([^/:*+]+|:[a-zA-Z0-9_]+))*/:[a-zA-Z0-9_]+[*+]|(/([^/:*+]+|:[a-zA-Z0-9_]+))+)$`),
+										"Paths must start with `/`, and may only use `:name` parameters, or a `:name*` or `:name+` parameter as the last segment.",
+									),
+								),
+							},
+						},
+						"flag": schema.StringAttribute{
+							Description: "The name of a feature flag that controls whether the paths are routed to the application.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"json": schema.StringAttribute{
+				Description: "The generated `microfrontends.json`.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+type MicrofrontendRoutingDefaultApp struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	Fallback  types.String `tfsdk:"fallback"`
+}
+
+type MicrofrontendRoutingApplication struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	Paths     []string     `tfsdk:"paths"`
+	Flag      types.String `tfsdk:"flag"`
+}
+
+type MicrofrontendRouting struct {
+	ID                   types.String `tfsdk:"id"`
+	MicrofrontendGroupID types.String `tfsdk:"microfrontend_group_id"`
+	TeamID               types.String `tfsdk:"team_id"`
+	DefaultApp           types.Object `tfsdk:"default_app"`
+	Applications         types.List   `tfsdk:"applications"`
+	JSON                 types.String `tfsdk:"json"`
+}
+
+func (m MicrofrontendRouting) defaultApp(ctx context.Context) (d MicrofrontendRoutingDefaultApp, diags diag.Diagnostics) {
+	diags = m.DefaultApp.As(ctx, &d, basetypes.ObjectAsOptions{
+		UnhandledNullAsEmpty:    false,
+		UnhandledUnknownAsEmpty: true,
+	})
+	return d, diags
+}
+
+func (m MicrofrontendRouting) applications(ctx context.Context) (a []MicrofrontendRoutingApplication, diags diag.Diagnostics) {
+	diags = m.Applications.ElementsAs(ctx, &a, false)
+	return a, diags
+}
+
+// known reports whether every value needed to validate and generate the routing is known.
+func (m MicrofrontendRouting) known(ctx context.Context) bool {
+	if m.DefaultApp.IsUnknown() || m.Applications.IsUnknown() || m.MicrofrontendGroupID.IsUnknown() {
+		return false
+	}
+	d, diags := m.defaultApp(ctx)
+	if diags.HasError() || d.ProjectID.IsUnknown() || d.Fallback.IsUnknown() {
+		return false
+	}
+	var apps []struct {
+		ProjectID types.String `tfsdk:"project_id"`
+		Paths     types.List   `tfsdk:"paths"`
+		Flag      types.String `tfsdk:"flag"`
+	}
+	if diags := m.Applications.ElementsAs(ctx, &apps, false); diags.HasError() {
+		return false
+	}
+	for _, a := range apps {
+		if a.ProjectID.IsUnknown() || a.Paths.IsUnknown() || a.Flag.IsUnknown() {
+			return false
+		}
+		for _, p := range a.Paths.Elements() {
+			if p.IsUnknown() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+var _ resource.ConfigValidator = &microfrontendRoutingPathsValidator{}
+
+// microfrontendRoutingPathsValidator validates that no path is served by more than one application, and that the
+// default application isn't also listed as an application.
+type microfrontendRoutingPathsValidator struct{}
+
+func (v *microfrontendRoutingPathsValidator) Description(ctx context.Context) string {
+	return "Validates that the paths of the applications do not overlap."
+}
+
+func (v *microfrontendRoutingPathsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v *microfrontendRoutingPathsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config MicrofrontendRouting
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !config.known(ctx) || config.DefaultApp.IsNull() || config.Applications.IsNull() {
+		return
+	}
+
+	defaultApp, diags := config.defaultApp(ctx)
+	resp.Diagnostics.Append(diags...)
+	apps, diags := config.applications(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]int{}
+	var paths [][]string
+	for i, app := range apps {
+		appPath := path.Root("applications").AtListIndex(i)
+		projectID := app.ProjectID.ValueString()
+		if projectID == defaultApp.ProjectID.ValueString() {
+			resp.Diagnostics.AddAttributeError(
+				appPath.AtName("project_id"),
+				"Invalid microfrontend routing",
+				fmt.Sprintf("Project %s is the default application, so it cannot also be listed as an application.", projectID),
+			)
+		}
+		if j, ok := seen[projectID]; ok {
+			resp.Diagnostics.AddAttributeError(
+				appPath.AtName("project_id"),
+				"Invalid microfrontend routing",
+				fmt.Sprintf("Project %s is listed as both application %d and application %d. Each application should only be listed once.", projectID, j, i),
+			)
+		}
+		seen[projectID] = i
+		paths = append(paths, app.Paths)
+	}
+
+	for _, o := range overlappingMicrofrontendPaths(paths) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("applications").AtListIndex(o.app).AtName("paths").AtListIndex(o.path),
+			"Overlapping microfrontend paths",
+			fmt.Sprintf("The path %q of application %d overlaps with the path %q of application %d, so requests could be routed to either.", paths[o.app][o.path], o.app, paths[o.otherApp][o.otherPath], o.otherApp),
+		)
+	}
+}
+
+func (r *microfrontendRoutingResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		&microfrontendRoutingPathsValidator{},
+	}
+}
+
+// validateGroup checks that the default application is the default app of the Microfrontend Group, and that
+// every application is a member of it. At plan time, a membership may be created in the same apply, so
+// applications that are not yet members are only warned about unless requireMembership is set.
+func (m MicrofrontendRouting) validateGroup(ctx context.Context, group client.MicrofrontendGroup, requireMembership bool) (diags diag.Diagnostics) {
+	defaultApp, d := m.defaultApp(ctx)
+	diags.Append(d...)
+	apps, d := m.applications(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if group.DefaultApp.ProjectID == "" {
+		diags.AddAttributeError(
+			path.Root("default_app"),
+			"Invalid microfrontend routing",
+			fmt.Sprintf("The Microfrontend Group %s does not have a default app.", group.ID),
+		)
+	} else if group.DefaultApp.ProjectID != defaultApp.ProjectID.ValueString() {
+		diags.AddAttributeError(
+			path.Root("default_app").AtName("project_id"),
+			"Invalid microfrontend routing",
+			fmt.Sprintf("The default app of the Microfrontend Group %s is project %s, not %s.", group.ID, group.DefaultApp.ProjectID, defaultApp.ProjectID.ValueString()),
+		)
+	}
+	for i, app := range apps {
+		if _, ok := group.Projects[app.ProjectID.ValueString()]; ok {
+			continue
+		}
+		appPath := path.Root("applications").AtListIndex(i).AtName("project_id")
+		if requireMembership {
+			diags.AddAttributeError(
+				appPath,
+				"Invalid microfrontend routing",
+				fmt.Sprintf("Project %s is not a member of the Microfrontend Group %s. Add it with a `vercel_microfrontend_group_membership` resource.", app.ProjectID.ValueString(), group.ID),
+			)
+			continue
+		}
+		diags.AddAttributeWarning(
+			appPath,
+			"Project is not a member of the Microfrontend Group",
+			fmt.Sprintf("Project %s is not currently a member of the Microfrontend Group %s. Applying will fail unless it is added by a `vercel_microfrontend_group_membership` resource that is created first, such as one this resource depends on.", app.ProjectID.ValueString(), group.ID),
+		)
+	}
+	return diags
+}
+
+type microfrontendsConfig struct {
+	Schema       string                             `json:"$schema"`
+	Applications map[string]microfrontendsConfigApp `json:"applications"`
+}
+
+type microfrontendsConfigApp struct {
+	Development *microfrontendsConfigDevelopment `json:"development,omitempty"`
+	Routing     []microfrontendsConfigRoute      `json:"routing,omitempty"`
+}
+
+type microfrontendsConfigDevelopment struct {
+	Fallback string `json:"fallback"`
+}
+
+type microfrontendsConfigRoute struct {
+	Flag  string   `json:"flag,omitempty"`
+	Paths []string `json:"paths"`
+}
+
+// render generates microfrontends.json. Applications are identified by their project names.
+func (m MicrofrontendRouting) render(ctx context.Context, c *client.Client) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	defaultApp, d := m.defaultApp(ctx)
+	diags.Append(d...)
+	apps, d := m.applications(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	teamID := m.TeamID.ValueString()
+	projectName := func(projectID string) (string, bool) {
+		project, err := c.GetProject(ctx, projectID, teamID)
+		if err != nil {
+			diags.AddError(
+				"Error generating microfrontend routing",
+				fmt.Sprintf("Could not read project %s, unexpected error: %s", projectID, err),
+			)
+			return "", false
+		}
+		return project.Name, true
+	}
+
+	config := microfrontendsConfig{
+		Schema:       "https://openapi.vercel.sh/microfrontends.json",
+		Applications: map[string]microfrontendsConfigApp{},
+	}
+	name, ok := projectName(defaultApp.ProjectID.ValueString())
+	if !ok {
+		return "", diags
+	}
+	app := microfrontendsConfigApp{}
+	if !defaultApp.Fallback.IsNull() {
+		app.Development = &microfrontendsConfigDevelopment{
+			Fallback: defaultApp.Fallback.ValueString(),
+		}
+	}
+	config.Applications[name] = app
+
+	for _, a := range apps {
+		name, ok := projectName(a.ProjectID.ValueString())
+		if !ok {
+			return "", diags
+		}
+		config.Applications[name] = microfrontendsConfigApp{
+			Routing: []microfrontendsConfigRoute{{
+				Flag:  a.Flag.ValueString(),
+				Paths: a.Paths,
+			}},
+		}
+	}
+
+	out, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		diags.AddError(
+			"Error generating microfrontend routing",
+			"Could not generate microfrontends.json, unexpected error: "+err.Error(),
+		)
+		return "", diags
+	}
+	return string(out) + "\n", diags
+}
+
+// ModifyPlan validates the routing against the Microfrontend Group and generates microfrontends.json, so that
+// both problems and the resulting file are visible at plan time.
+func (r *microfrontendRoutingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan MicrofrontendRouting
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !plan.known(ctx) {
+		return
+	}
+	if plan.TeamID.IsUnknown() {
+		plan.TeamID = types.StringNull()
+	}
+
+	group, err := r.client.GetMicrofrontendGroup(ctx, plan.MicrofrontendGroupID.ValueString(), plan.TeamID.ValueString())
+	if err != nil {
+		// The group may not exist yet, or the token may not be able to read it. It is checked again on apply.
+		tflog.Info(ctx, "unable to read microfrontend group at plan time", map[string]any{
+			"microfrontend_group_id": plan.MicrofrontendGroupID.ValueString(),
+			"error":                  err.Error(),
+		})
+		return
+	}
+	resp.Diagnostics.Append(plan.validateGroup(ctx, group, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rendered, diags := plan.render(ctx, r.client)
+	if diags.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("json"), types.StringValue(rendered))...)
+}
+
+// apply validates the routing against the Microfrontend Group, and generates microfrontends.json.
+func (r *microfrontendRoutingResource) apply(ctx context.Context, plan MicrofrontendRouting) (MicrofrontendRouting, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	group, err := r.client.GetMicrofrontendGroup(ctx, plan.MicrofrontendGroupID.ValueString(), plan.TeamID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error reading microfrontend group",
+			fmt.Sprintf("Could not read microfrontend group %s, unexpected error: %s", plan.MicrofrontendGroupID.ValueString(), err),
+		)
+		return plan, diags
+	}
+	diags.Append(plan.validateGroup(ctx, group, true)...)
+	if diags.HasError() {
+		return plan, diags
+	}
+
+	result := plan
+	result.ID = types.StringValue(group.ID)
+	result.TeamID = types.StringValue(group.TeamID)
+	if plan.JSON.IsUnknown() {
+		rendered, d := plan.render(ctx, r.client)
+		diags.Append(d...)
+		if diags.HasError() {
+			return plan, diags
+		}
+		result.JSON = types.StringValue(rendered)
+	}
+	return result, diags
+}
+
+func (r *microfrontendRoutingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan MicrofrontendRouting
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.apply(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "created microfrontend routing", map[string]any{
+		"team_id":                result.TeamID.ValueString(),
+		"microfrontend_group_id": result.MicrofrontendGroupID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read regenerates microfrontends.json, so that renamed projects are reflected in it.
+func (r *microfrontendRoutingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state MicrofrontendRouting
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rendered, diags := state.render(ctx, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.JSON = types.StringValue(rendered)
+	tflog.Info(ctx, "read microfrontend routing", map[string]any{
+		"team_id":                state.TeamID.ValueString(),
+		"microfrontend_group_id": state.MicrofrontendGroupID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *microfrontendRoutingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan MicrofrontendRouting
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.apply(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "updated microfrontend routing", map[string]any{
+		"team_id":                result.TeamID.ValueString(),
+		"microfrontend_group_id": result.MicrofrontendGroupID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete does nothing, as the routing only exists in the generated microfrontends.json.
+func (r *microfrontendRoutingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state MicrofrontendRouting
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "deleted microfrontend routing", map[string]any{
+		"team_id":                state.TeamID.ValueString(),
+		"microfrontend_group_id": state.MicrofrontendGroupID.ValueString(),
+	})
+}
@@ -0,0 +1,162 @@
+package vercel_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAccMicrofrontendRoutingConfig creates a Microfrontend Group with test-2 as a member, and routing
+// for it. Any extra members are added to the group before the routing is created.
+func testAccMicrofrontendRoutingConfig(name, routing string, extraMembers ...string) string {
+	dependsOn := "vercel_microfrontend_group_membership.test-2"
+	memberships := ""
+	for _, member := range extraMembers {
+		dependsOn += ", vercel_microfrontend_group_membership." + member
+		memberships += fmt.Sprintf(`
+resource "vercel_microfrontend_group_membership" "%[1]s" {
+  project_id             = vercel_project.%[1]s.id
+  microfrontend_group_id = vercel_microfrontend_group.test.id
+}
+`, member)
+	}
+	return cfg(fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-project-%[1]s"
+}
+resource "vercel_project" "test-2" {
+  name = "test-acc-project-2-%[1]s"
+}
+resource "vercel_project" "test-3" {
+  name = "test-acc-project-3-%[1]s"
+}
+resource "vercel_microfrontend_group" "test" {
+  name = "test-acc-microfrontend-group-%[1]s"
+  default_app = {
+    project_id = vercel_project.test.id
+  }
+}
+resource "vercel_microfrontend_group_membership" "test-2" {
+  project_id             = vercel_project.test-2.id
+  microfrontend_group_id = vercel_microfrontend_group.test.id
+}
+resource "vercel_microfrontend_routing" "test" {
+  microfrontend_group_id = vercel_microfrontend_group.test.id
+  default_app = {
+    project_id = vercel_project.test.id
+    fallback   = "test-acc-project-%[1]s.vercel.app"
+  }
+  %[2]s
+  depends_on = [%[3]s]
+}
+%[4]s`, name, routing, dependsOn, memberships))
+}
+
+func TestAcc_MicrofrontendRoutingResource(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMicrofrontendRoutingConfig(name, `
+  applications = [
+    {
+      project_id = vercel_project.test-2.id
+      paths      = ["/docs", "/docs/:path*"]
+    },
+    {
+      project_id = vercel_project.test-3.id
+      paths      = ["/docs/:slug"]
+    }
+  ]
+`),
+				ExpectError: regexp.MustCompile(`overlaps\s+with\s+the\s+path`),
+			},
+			{
+				Config: testAccMicrofrontendRoutingConfig(name, `
+  applications = [
+    {
+      project_id = vercel_project.test.id
+      paths      = ["/docs"]
+    }
+  ]
+`),
+				ExpectError: regexp.MustCompile(`is\s+the\s+default\s+application`),
+			},
+			{
+				Config: testAccMicrofrontendRoutingConfig(name, `
+  applications = [
+    {
+      project_id = vercel_project.test-2.id
+      paths      = ["/docs/:path*"]
+    },
+    {
+      project_id = vercel_project.test-3.id
+      paths      = ["/blog"]
+    }
+  ]
+`),
+				ExpectError: regexp.MustCompile(`is\s+not\s+a\s+member\s+of\s+the\s+Microfrontend\s+Group`),
+			},
+			{
+				Config: testAccMicrofrontendRoutingConfig(name, `
+  applications = [
+    {
+      project_id = vercel_project.test-2.id
+      paths      = ["/docs", "/docs/:path*"]
+      flag       = "enable-docs"
+    }
+  ]
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("vercel_microfrontend_routing.test", "id", "vercel_microfrontend_group.test", "id"),
+					resource.TestCheckResourceAttr("vercel_microfrontend_routing.test", "json", fmt.Sprintf(`{
+  "$schema": "https://openapi.vercel.sh/microfrontends.json",
+  "applications": {
+    "test-acc-project-%[1]s": {
+      "development": {
+        "fallback": "test-acc-project-%[1]s.vercel.app"
+      }
+    },
+    "test-acc-project-2-%[1]s": {
+      "routing": [
+        {
+          "flag": "enable-docs",
+          "paths": [
+            "/docs",
+            "/docs/:path*"
+          ]
+        }
+      ]
+    }
+  }
+}
+`, name)),
+				),
+			},
+			{
+				// The group already exists, so adding a membership and routing to it in the same apply
+				// must not fail at plan time.
+				Config: testAccMicrofrontendRoutingConfig(name, `
+  applications = [
+    {
+      project_id = vercel_project.test-2.id
+      paths      = ["/docs", "/docs/:path*"]
+    },
+    {
+      project_id = vercel_project.test-3.id
+      paths      = ["/blog"]
+    }
+  ]
+`, "test-3"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_microfrontend_routing.test", "applications.#", "2"),
+					resource.TestCheckResourceAttrPair("vercel_microfrontend_routing.test", "applications.1.project_id", "vercel_project.test-3", "id"),
+				),
+			},
+		},
+	})
+}
@@ -184,8 +184,8 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 							Required:    true,
 						},
 						"value": schema.StringAttribute{
-							Description: "The value of the Environment Variable.",
-							Required:    true,
+							Description: "The value of the Environment Variable. If omitted, the value is taken from `environment_values_wo` using the key of the Environment Variable.",
+							Optional:    true,
 							Sensitive:   true,
 						},
 						"id": schema.StringAttribute{
@@ -208,6 +208,20 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 					},
 				},
 			},
+			"environment_values_wo": schema.MapAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "Values for any `environment` variables that do not set a `value`, keyed by the Environment Variable key. As there is one value for each key, only one Environment Variable with each key can omit `value`. To use different write-only values for the same key across targets or branches, use a `vercel_project_environment_variable` resource with `value_wo` for each of them. This is a write-only attribute, so the values are never stored in the plan or state. Terraform cannot detect changes to a write-only value, so `environment_values_wo_version` must be changed for new values to be applied. Requires Terraform 1.11 or later.",
+			},
+			"environment_values_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The version of `environment_values_wo`. Change this to update every Environment Variable that takes its value from `environment_values_wo`.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("environment_values_wo")),
+				},
+			},
 			"framework": schema.StringAttribute{
 				Optional:    true,
 				Description: "The framework that is being used for this project. If omitted, no framework is selected.",
@@ -641,6 +655,8 @@ type Project struct {
 	BuildCommand                        types.String `tfsdk:"build_command"`
 	DevCommand                          types.String `tfsdk:"dev_command"`
 	Environment                         types.Set    `tfsdk:"environment"`
+	EnvironmentValuesWO                 types.Map    `tfsdk:"environment_values_wo"`
+	EnvironmentValuesWOVersion          types.Int64  `tfsdk:"environment_values_wo_version"`
 	Framework                           types.String `tfsdk:"framework"`
 	GitRepository                       types.Object `tfsdk:"git_repository"`
 	ID                                  types.String `tfsdk:"id"`
@@ -738,7 +754,7 @@ func (p *Project) environment(ctx context.Context) ([]EnvironmentItem, error) {
 	return vars, nil
 }
 
-func parseEnvironment(ctx context.Context, vars []EnvironmentItem) (out []client.EnvironmentVariable, diags diag.Diagnostics) {
+func parseEnvironment(ctx context.Context, vars []EnvironmentItem, writeOnlyValues map[string]string) (out []client.EnvironmentVariable, diags diag.Diagnostics) {
 	for _, e := range vars {
 		var target []string
 		diags = e.Target.ElementsAs(ctx, &target, true)
@@ -760,7 +776,7 @@ func parseEnvironment(ctx context.Context, vars []EnvironmentItem) (out []client
 
 		out = append(out, client.EnvironmentVariable{
 			Key:                  e.Key.ValueString(),
-			Value:                e.Value.ValueString(),
+			Value:                e.requestValue(writeOnlyValues),
 			Target:               target,
 			CustomEnvironmentIDs: customEnvironmentIDs,
 			GitBranch:            e.GitBranch.ValueStringPointer(),
@@ -871,8 +887,8 @@ func (g *GitProviderOptions) toUpdateProjectRequest() *client.GitProviderOptions
 	}
 }
 
-func (p *Project) toCreateProjectRequest(ctx context.Context, envs []EnvironmentItem) (req client.CreateProjectRequest, diags diag.Diagnostics) {
-	clientEnvs, diags := parseEnvironment(ctx, envs)
+func (p *Project) toCreateProjectRequest(ctx context.Context, envs []EnvironmentItem, writeOnlyValues map[string]string) (req client.CreateProjectRequest, diags diag.Diagnostics) {
+	clientEnvs, diags := parseEnvironment(ctx, envs, writeOnlyValues)
 	if diags.HasError() {
 		return req, diags
 	}
@@ -1053,7 +1069,7 @@ func (e *EnvironmentItem) toAttrValue() attr.Value {
 	})
 }
 
-func (e *EnvironmentItem) toEnvironmentVariableRequest(ctx context.Context) (req client.EnvironmentVariableRequest, diags diag.Diagnostics) {
+func (e *EnvironmentItem) toEnvironmentVariableRequest(ctx context.Context, writeOnlyValues map[string]string) (req client.EnvironmentVariableRequest, diags diag.Diagnostics) {
 	var target []string
 	diags = e.Target.ElementsAs(ctx, &target, true)
 	if diags.HasError() {
@@ -1074,7 +1090,7 @@ func (e *EnvironmentItem) toEnvironmentVariableRequest(ctx context.Context) (req
 
 	return client.EnvironmentVariableRequest{
 		Key:                  e.Key.ValueString(),
-		Value:                e.Value.ValueString(),
+		Value:                e.requestValue(writeOnlyValues),
 		Target:               target,
 		CustomEnvironmentIDs: customEnvironmentIDs,
 		GitBranch:            e.GitBranch.ValueStringPointer(),
@@ -1120,6 +1136,19 @@ func (g *GitRepository) toCreateProjectRequest() *client.GitRepository {
 	}
 }
 
+// withUnmanagedProtectionBypass leaves Protection Bypass for Automation unset when it is not configured, as the
+// project's secrets may instead be managed by the vercel_project_protection_bypass resource. A prior state
+// without an ID is an import placeholder, so the secret read from the API is kept to be adopted.
+func (p *Project) withUnmanagedProtectionBypass(prior Project) {
+	if prior.ID.IsNull() {
+		return
+	}
+	if prior.ProtectionBypassForAutomation.IsNull() {
+		p.ProtectionBypassForAutomation = types.BoolNull()
+		p.ProtectionBypassForAutomationSecret = types.StringNull()
+	}
+}
+
 func toApiDeploymentProtectionType(dt types.String) string {
 	switch dt {
 	case types.StringValue("standard_protection"):
@@ -1641,6 +1670,10 @@ func convertResponseToProject(ctx context.Context, response client.ProjectRespon
 		})
 	}
 
+	planEnvironment, err := plan.environment(ctx)
+	if err != nil {
+		return Project{}, fmt.Errorf("error reading project environment variables: %s", err)
+	}
 	var env []attr.Value
 	for _, e := range environmentVariables {
 		var targetValue attr.Value
@@ -1667,25 +1700,25 @@ func convertResponseToProject(ctx context.Context, response client.ProjectRespon
 		value := types.StringValue(e.Value)
 		if e.Type == "sensitive" {
 			value = types.StringNull()
-			environment, err := plan.environment(ctx)
-			if err != nil {
-				return Project{}, fmt.Errorf("error reading project environment variables: %s", err)
+		}
+		for _, p := range planEnvironment {
+			var target []string
+			diags := p.Target.ElementsAs(ctx, &target, true)
+			if diags.HasError() {
+				return Project{}, fmt.Errorf("error reading project environment variables: %s", diags)
 			}
-			for _, p := range environment {
-				var target []string
-				diags := p.Target.ElementsAs(ctx, &target, true)
-				if diags.HasError() {
-					return Project{}, fmt.Errorf("error reading project environment variables: %s", diags)
-				}
-				var customEnvironmentIDs []string
-				diags = p.CustomEnvironmentIDs.ElementsAs(ctx, &customEnvironmentIDs, true)
-				if diags.HasError() {
-					return Project{}, fmt.Errorf("error reading project environment variables: %s", diags)
-				}
-				if p.Key.ValueString() == e.Key && isSameStringSet(target, e.Target) && isSameStringSet(customEnvironmentIDs, e.CustomEnvironmentIDs) {
+			var customEnvironmentIDs []string
+			diags = p.CustomEnvironmentIDs.ElementsAs(ctx, &customEnvironmentIDs, true)
+			if diags.HasError() {
+				return Project{}, fmt.Errorf("error reading project environment variables: %s", diags)
+			}
+			if p.Key.ValueString() == e.Key && isSameStringSet(target, e.Target) && isSameStringSet(customEnvironmentIDs, e.CustomEnvironmentIDs) {
+				// Use the planned value if the API can't return the value, or if the value is
+				// write-only and so must not be stored in state.
+				if e.Type == "sensitive" || p.Value.IsNull() {
 					value = p.Value
-					break
 				}
+				break
 			}
 		}
 
@@ -1755,6 +1788,8 @@ func convertResponseToProject(ctx context.Context, response client.ProjectRespon
 		BuildCommand:                        uncoerceString(fields.BuildCommand, types.StringPointerValue(response.BuildCommand)),
 		DevCommand:                          uncoerceString(fields.DevCommand, types.StringPointerValue(response.DevCommand)),
 		Environment:                         environmentEntry,
+		EnvironmentValuesWO:                 types.MapNull(types.StringType),
+		EnvironmentValuesWOVersion:          plan.EnvironmentValuesWOVersion,
 		Framework:                           types.StringPointerValue(response.Framework),
 		GitRepository:                       gitRepoObj,
 		ID:                                  types.StringValue(response.ID),
@@ -1817,6 +1852,12 @@ func (r *projectResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 		return
 	}
 
+	diags = validateWriteOnlyEnvironmentValues(ctx, req.Config, environment, config.Environment.Elements(), path.Root("environment"), path.Root("environment_values_wo"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// work out if there are any new env vars that are specifying sensitive = false
 	var nonSensitiveEnvVars []path.Path
 	for i, e := range environment {
@@ -1882,7 +1923,13 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	request, diags := plan.toCreateProjectRequest(ctx, environment)
+	writeOnlyValues, diags := writeOnlyEnvironmentValues(ctx, req.Config, path.Root("environment_values_wo"))
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	request, diags := plan.toCreateProjectRequest(ctx, environment, writeOnlyValues)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -2113,6 +2160,7 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		)
 		return
 	}
+	result.withUnmanagedProtectionBypass(state)
 	tflog.Info(ctx, "read project", map[string]any{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ID.ValueString(),
@@ -2243,6 +2291,9 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	})
 
 	toCreate, toRemove := diffEnvVars(stateEnvs, planEnvs)
+	if !plan.EnvironmentValuesWOVersion.Equal(state.EnvironmentValuesWOVersion) {
+		toCreate, toRemove = rotateWriteOnlyEnvVars(stateEnvs, planEnvs, toCreate, toRemove)
+	}
 	for _, v := range toRemove {
 		err := r.client.DeleteEnvironmentVariable(ctx, state.ID.ValueString(), state.TeamID.ValueString(), v.ID.ValueString())
 		if err != nil {
@@ -2264,9 +2315,14 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		})
 	}
 
+	writeOnlyValues, diags := writeOnlyEnvironmentValues(ctx, req.Config, path.Root("environment_values_wo"))
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
 	var items []client.EnvironmentVariableRequest
 	for _, v := range toCreate {
-		vv, diags := v.toEnvironmentVariableRequest(ctx)
+		vv, diags := v.toEnvironmentVariableRequest(ctx, writeOnlyValues)
 		if diags.HasError() {
 			resp.Diagnostics.Append(diags...)
 			return
@@ -2497,6 +2553,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		)
 		return
 	}
+	result.withUnmanagedProtectionBypass(plan)
 	tflog.Info(ctx, "updated project", map[string]any{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ID.ValueString(),
@@ -0,0 +1,427 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Compile-time assertions to ensure the implementation conforms to the expected interfaces.
+var (
+	_ resource.Resource                = &projectCronJobResource{}
+	_ resource.ResourceWithConfigure   = &projectCronJobResource{}
+	_ resource.ResourceWithImportState = &projectCronJobResource{}
+	_ resource.ResourceWithModifyPlan  = &projectCronJobResource{}
+)
+
+// cronJobNextRuns is the number of upcoming run times exposed by the next_runs attribute.
+const cronJobNextRuns = 5
+
+func newProjectCronJobResource() resource.Resource {
+	return &projectCronJobResource{}
+}
+
+type projectCronJobResource struct {
+	client *client.Client
+}
+
+func (r *projectCronJobResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_cron_job"
+}
+
+func (r *projectCronJobResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cli, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = cli
+}
+
+func (r *projectCronJobResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Project Cron Job resource.
+
+Cron Jobs are defined in the ` + "`crons`" + ` field of ` + "`vercel.json`" + ` and take effect when a production deployment is created. This resource declares a Cron Job that is expected to be part of the project's current production deployment. The schedule is validated against the limits of the team's plan, and the ` + "`deployed`" + ` and ` + "`deployed_schedule`" + ` attributes report whether the production deployment runs the Cron Job as declared.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/cron-jobs).
+
+~> Creating or updating this resource does not change the Cron Jobs of the project. If the production deployment does not run the Cron Job with the declared schedule, a warning is shown and ` + "`deployed`" + ` is ` + "`false`" + ` until a production deployment that includes it is created.
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description:   "The ID of the Project the Cron Job belongs to.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team the Project exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseNonNullStateForUnknown()},
+			},
+			"path": schema.StringAttribute{
+				Description:   "The path the Cron Job invokes, such as `/api/cron`. This must start with `/`.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/`), "The path must start with `/`."),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				Description: "The cron expression for the Cron Job, in UTC. This must have five fields (minute, hour, day of month, month and day of week). Hobby teams may only run a Cron Job once per day.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+					validateCronExpression(),
+				},
+			},
+			"deployment_id": schema.StringAttribute{
+				Description: "The ID of the production deployment that runs the Cron Job.",
+				Computed:    true,
+			},
+			"deployed_schedule": schema.StringAttribute{
+				Description: "The schedule the production deployment runs the Cron Job with, or null if the production deployment does not include the Cron Job.",
+				Computed:    true,
+			},
+			"deployed": schema.BoolAttribute{
+				Description: "Whether the production deployment runs the Cron Job with the declared `schedule`.",
+				Computed:    true,
+			},
+			"next_runs": schema.ListAttribute{
+				Description: "The next times the Cron Job is scheduled to run, in RFC 3339 format.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// ProjectCronJob reflects the state terraform stores internally for a project cron job.
+type ProjectCronJob struct {
+	ProjectID        types.String `tfsdk:"project_id"`
+	TeamID           types.String `tfsdk:"team_id"`
+	Path             types.String `tfsdk:"path"`
+	Schedule         types.String `tfsdk:"schedule"`
+	DeploymentID     types.String `tfsdk:"deployment_id"`
+	DeployedSchedule types.String `tfsdk:"deployed_schedule"`
+	Deployed         types.Bool   `tfsdk:"deployed"`
+	NextRuns         types.List   `tfsdk:"next_runs"`
+}
+
+// cronJobNextRunsValue returns the next_runs attribute for a cron expression.
+func cronJobNextRunsValue(schedule string) types.List {
+	s, err := parseCronExpression(schedule)
+	if err != nil {
+		return types.ListNull(types.StringType)
+	}
+	runs := []attr.Value{}
+	for _, t := range s.nextRuns(time.Now().UTC(), cronJobNextRuns) {
+		runs = append(runs, types.StringValue(t.Format(time.RFC3339)))
+	}
+	return types.ListValueMust(types.StringType, runs)
+}
+
+// findCronDefinition returns the cron job for a path in the production deployment, if any.
+func findCronDefinition(definitions client.ProjectCronDefinitions, path string) (client.CronDefinition, bool) {
+	for _, d := range definitions.Definitions {
+		if d.Path == path {
+			return d, true
+		}
+	}
+	return client.CronDefinition{}, false
+}
+
+// toProjectCronJob builds the state for a cron job with the declared schedule, from the cron jobs of the
+// production deployment. If the deployment doesn't include the path, deployment_id and deployed_schedule
+// are null. An empty schedule, as when importing, takes the schedule of the production deployment.
+func toProjectCronJob(definitions client.ProjectCronDefinitions, path, schedule string) (ProjectCronJob, bool) {
+	result := ProjectCronJob{
+		ProjectID:        types.StringValue(definitions.ProjectID),
+		TeamID:           toTeamID(definitions.TeamID),
+		Path:             types.StringValue(path),
+		Schedule:         types.StringValue(schedule),
+		DeploymentID:     types.StringNull(),
+		DeployedSchedule: types.StringNull(),
+	}
+	d, ok := findCronDefinition(definitions, path)
+	if ok {
+		result.DeploymentID = types.StringValue(definitions.DeploymentID)
+		result.DeployedSchedule = types.StringValue(d.Schedule)
+		if schedule == "" {
+			result.Schedule = types.StringValue(d.Schedule)
+		}
+	}
+	result.Deployed = types.BoolValue(ok && result.DeployedSchedule.Equal(result.Schedule))
+	result.NextRuns = cronJobNextRunsValue(result.Schedule.ValueString())
+	return result, ok
+}
+
+// warnIfNotDeployed adds a warning if the production deployment doesn't run the cron job as declared.
+func warnIfNotDeployed(result ProjectCronJob, diags *diag.Diagnostics) {
+	if result.DeployedSchedule.IsNull() {
+		diags.AddWarning(
+			"Cron Job is not deployed",
+			fmt.Sprintf(
+				"The current production deployment does not include a Cron Job for %s. Add it to the `crons` field of `vercel.json` and create a new production deployment for it to run.",
+				result.Path.ValueString(),
+			),
+		)
+		return
+	}
+	if !result.Deployed.ValueBool() {
+		diags.AddWarning(
+			"Cron Job schedule differs from the production deployment",
+			fmt.Sprintf(
+				"The current production deployment runs the Cron Job for %s with the schedule %q, not %q. Update the `crons` field of `vercel.json` and create a new production deployment for the new schedule to take effect.",
+				result.Path.ValueString(),
+				result.DeployedSchedule.ValueString(),
+				result.Schedule.ValueString(),
+			),
+		)
+	}
+}
+
+// ModifyPlan validates the schedule against the Cron Job limits of the team's plan.
+func (r *projectCronJobResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var config ProjectCronJob
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.Schedule.IsUnknown() || config.TeamID.IsUnknown() {
+		return
+	}
+	schedule, err := parseCronExpression(config.Schedule.ValueString())
+	if err != nil || !schedule.runsMoreThanDaily() {
+		return
+	}
+
+	// Personal accounts are always on the hobby plan.
+	plan := "hobby"
+	if teamID := r.client.TeamID(config.TeamID.ValueString()); teamID != "" {
+		team, err := r.client.GetTeam(ctx, teamID)
+		if err != nil {
+			tflog.Info(ctx, "unable to read team plan, skipping cron job frequency validation", map[string]any{
+				"team_id": teamID,
+				"error":   err.Error(),
+			})
+			return
+		}
+		if team.Billing == nil {
+			return
+		}
+		plan = team.Billing.Plan
+	}
+
+	if plan == "hobby" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("schedule"),
+			"Cron Job runs too frequently",
+			fmt.Sprintf("The schedule %q runs more than once per day, but Cron Jobs on the Hobby plan can only run once per day.", config.Schedule.ValueString()),
+		)
+	}
+}
+
+func (r *projectCronJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectCronJob
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetProjectCronDefinitions(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error creating project cron job",
+			"Could not find project, please make sure both the project_id and team_id match the project and team you wish to configure.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating project cron job",
+			"Error reading project information, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result, _ := toProjectCronJob(out, plan.Path.ValueString(), plan.Schedule.ValueString())
+	warnIfNotDeployed(result, &resp.Diagnostics)
+	tflog.Info(ctx, "created project cron job", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"path":       result.Path.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectCronJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectCronJob
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetProjectCronDefinitions(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading project cron job",
+			fmt.Sprintf("Could not get project cron job %s %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.ProjectID.ValueString(),
+				state.Path.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	// If the production deployment no longer runs the cron job as declared, this is reported through
+	// deployed and deployed_schedule, rather than by removing the cron job from state.
+	result, _ := toProjectCronJob(out, state.Path.ValueString(), state.Schedule.ValueString())
+	tflog.Info(ctx, "read project cron job", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"path":       result.Path.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectCronJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ProjectCronJob
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetProjectCronDefinitions(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error updating project cron job",
+			fmt.Sprintf("Could not update project cron job %s %s %s, as the project could not be found",
+				plan.TeamID.ValueString(),
+				plan.ProjectID.ValueString(),
+				plan.Path.ValueString(),
+			),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating project cron job",
+			fmt.Sprintf("Could not update project cron job %s %s %s, unexpected error: %s",
+				plan.TeamID.ValueString(),
+				plan.ProjectID.ValueString(),
+				plan.Path.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result, _ := toProjectCronJob(out, plan.Path.ValueString(), plan.Schedule.ValueString())
+	warnIfNotDeployed(result, &resp.Diagnostics)
+	tflog.Info(ctx, "updated project cron job", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"path":       result.Path.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete only removes the Cron Job from state, as Cron Jobs are removed by deploying without them.
+func (r *projectCronJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectCronJob
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "deleted project cron job", map[string]any{
+		"team_id":    state.TeamID.ValueString(),
+		"project_id": state.ProjectID.ValueString(),
+		"path":       state.Path.ValueString(),
+	})
+}
+
+// ImportState takes an identifier and reads the Cron Job from the project's production deployment.
+func (r *projectCronJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids, cronPath, found := strings.Cut(req.ID, ":")
+	teamID, projectID, ok := splitInto1Or2(ids)
+	if !found || !ok || !strings.HasPrefix(cronPath, "/") {
+		resp.Diagnostics.AddError(
+			"Error importing project cron job",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/project_id:/path\" or \"project_id:/path\"", req.ID),
+		)
+		return
+	}
+
+	out, err := r.client.GetProjectCronDefinitions(ctx, projectID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing project cron job",
+			fmt.Sprintf("Could not get project cron job %s %s %s, unexpected error: %s", teamID, projectID, cronPath, err),
+		)
+		return
+	}
+
+	result, ok := toProjectCronJob(out, cronPath, "")
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing project cron job",
+			fmt.Sprintf("The current production deployment of project %s does not include a Cron Job for %s", projectID, cronPath),
+		)
+		return
+	}
+	tflog.Info(ctx, "imported project cron job", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"path":       result.Path.ValueString(),
+	})
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,125 @@
+package vercel_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAcc_ProjectCronJob(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.example", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config:      cfg(testAccProjectCronJobConfig(nameSuffix, "0 5 * *")),
+				ExpectError: regexp.MustCompile("expected 5 fields"),
+			},
+			{
+				Config:      cfg(testAccProjectCronJobConfig(nameSuffix, "0 0 30 2 *")),
+				ExpectError: regexp.MustCompile("never runs"),
+			},
+			{
+				Config:      cfg(testAccProjectCronJobConfig(nameSuffix, "0 5 * * 8")),
+				ExpectError: regexp.MustCompile(`day\s+of\s+week\s+field\s+must\s+be\s+between\s+0\s+and\s+7`),
+			},
+			{
+				Config: cfg(testAccProjectCronJobConfig(nameSuffix, "0 5 * * *")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_project_cron_job.example", "path", "/api/cron"),
+					resource.TestCheckResourceAttr("vercel_project_cron_job.example", "schedule", "0 5 * * *"),
+					resource.TestCheckResourceAttrPair("vercel_project_cron_job.example", "deployment_id", "vercel_deployment.example", "id"),
+					resource.TestCheckResourceAttr("vercel_project_cron_job.example", "deployed_schedule", "0 5 * * *"),
+					resource.TestCheckResourceAttr("vercel_project_cron_job.example", "deployed", "true"),
+					resource.TestCheckResourceAttr("vercel_project_cron_job.example", "next_runs.#", "5"),
+				),
+			},
+			{
+				ResourceName:                         "vercel_project_cron_job.example",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIgnore:              []string{"next_runs"},
+				ImportStateIdFunc:                    getProjectCronJobImportID("vercel_project_cron_job.example"),
+				ImportStateVerifyIdentifierAttribute: "path",
+			},
+			{
+				// The production deployment still runs the cron job at 5am, so the new schedule is
+				// stored but is not deployed.
+				Config: cfg(testAccProjectCronJobConfig(nameSuffix, "0 6 * * *")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_project_cron_job.example", "schedule", "0 6 * * *"),
+					resource.TestCheckResourceAttr("vercel_project_cron_job.example", "deployed_schedule", "0 5 * * *"),
+					resource.TestCheckResourceAttr("vercel_project_cron_job.example", "deployed", "false"),
+				),
+			},
+			{
+				// A cron job that is not part of the production deployment stays in state.
+				Config: cfg(testAccProjectCronJobConfig(nameSuffix, "0 6 * * *") + `
+resource "vercel_project_cron_job" "missing" {
+  project_id = vercel_project.example.id
+  path       = "/api/missing"
+  schedule   = "0 5 * * *"
+
+  depends_on = [vercel_deployment.example]
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_project_cron_job.missing", "deployed", "false"),
+					resource.TestCheckNoResourceAttr("vercel_project_cron_job.missing", "deployed_schedule"),
+					resource.TestCheckNoResourceAttr("vercel_project_cron_job.missing", "deployment_id"),
+				),
+			},
+			{
+				// 7 is accepted as Sunday, like 0.
+				Config:             cfg(testAccProjectCronJobConfig(nameSuffix, "0 5 * * 7")),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func getProjectCronJobImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s/%s:%s", rs.Primary.Attributes["team_id"], rs.Primary.Attributes["project_id"], rs.Primary.Attributes["path"]), nil
+	}
+}
+
+func testAccProjectCronJobConfig(projectName, schedule string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "example" {
+  name = "test-acc-cron-job-%[1]s"
+}
+
+data "vercel_project_directory" "example" {
+  path = "examples/crons"
+}
+
+resource "vercel_deployment" "example" {
+  project_id  = vercel_project.example.id
+  files       = data.vercel_project_directory.example.files
+  path_prefix = data.vercel_project_directory.example.path
+  production  = true
+}
+
+resource "vercel_project_cron_job" "example" {
+  project_id = vercel_project.example.id
+  path       = "/api/cron"
+  schedule   = "%[2]s"
+
+  depends_on = [vercel_deployment.example]
+}
+`, projectName, schedule)
+}
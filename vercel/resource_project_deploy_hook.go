@@ -0,0 +1,303 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &projectDeployHookResource{}
+	_ resource.ResourceWithConfigure   = &projectDeployHookResource{}
+	_ resource.ResourceWithImportState = &projectDeployHookResource{}
+)
+
+func newProjectDeployHookResource() resource.Resource {
+	return &projectDeployHookResource{}
+}
+
+type projectDeployHookResource struct {
+	client *client.Client
+}
+
+func (r *projectDeployHookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_deploy_hook"
+}
+
+func (r *projectDeployHookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema returns the schema information for a project deploy hook resource.
+func (r *projectDeployHookResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Deploy Hook resource.
+
+Deploy Hooks are unique URLs that allow you to trigger a deployment of a given branch. The project must be connected to a git repository.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/deployments/deploy-hooks).
+
+~> Deploy Hooks should be managed either with this resource, or with the ` + "`git_repository.deploy_hooks`" + ` field of the ` + "`vercel_project`" + ` resource, but not both.
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description:   "The ID of the Project that the Deploy Hook belongs to.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team the Project exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseNonNullStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				Description:   "The ID of the Deploy Hook.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Description:   "The name of the Deploy Hook.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"ref": schema.StringAttribute{
+				Description:   "The branch or commit hash that should be deployed.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description:   "A URL that, when a POST request is made to, will trigger a new deployment.",
+				Computed:      true,
+				Sensitive:     true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// ProjectDeployHook reflects the state terraform stores internally for a project deploy hook.
+type ProjectDeployHook struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Ref       types.String `tfsdk:"ref"`
+	URL       types.String `tfsdk:"url"`
+}
+
+func responseToProjectDeployHook(out client.DeployHook, projectID, teamID string) ProjectDeployHook {
+	return ProjectDeployHook{
+		ProjectID: types.StringValue(projectID),
+		TeamID:    toTeamID(teamID),
+		ID:        types.StringValue(out.ID),
+		Name:      types.StringValue(out.Name),
+		Ref:       types.StringValue(out.Ref),
+		URL:       types.StringValue(out.URL),
+	}
+}
+
+// Create will create a deploy hook within Vercel.
+// This is called automatically by the provider when a new resource should be created.
+func (r *projectDeployHookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectDeployHook
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.CreateDeployHook(ctx, client.CreateDeployHookRequest{
+		ProjectID: plan.ProjectID.ValueString(),
+		TeamID:    plan.TeamID.ValueString(),
+		Name:      plan.Name.ValueString(),
+		Ref:       plan.Ref.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Deploy Hook",
+			"Could not create Deploy Hook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result := responseToProjectDeployHook(out, plan.ProjectID.ValueString(), r.client.TeamID(plan.TeamID.ValueString()))
+	tflog.Info(ctx, "created deploy hook", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"hook_id":    result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read will read deploy hook information by requesting it from the Vercel API, and will update terraform
+// with this information.
+func (r *projectDeployHookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectDeployHook
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetDeployHook(ctx, client.GetDeployHookRequest{
+		ProjectID: state.ProjectID.ValueString(),
+		TeamID:    state.TeamID.ValueString(),
+		ID:        state.ID.ValueString(),
+	})
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Deploy Hook",
+			fmt.Sprintf("Could not get Deploy Hook %s %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.ProjectID.ValueString(),
+				state.ID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result := responseToProjectDeployHook(out, state.ProjectID.ValueString(), r.client.TeamID(state.TeamID.ValueString()))
+	tflog.Info(ctx, "read deploy hook", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"hook_id":    result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update does nothing.
+func (r *projectDeployHookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Updating a Deploy Hook is not supported",
+		"Updating a Deploy Hook is not supported",
+	)
+}
+
+// Delete deletes a Deploy Hook.
+func (r *projectDeployHookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectDeployHook
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDeployHook(ctx, client.DeleteDeployHookRequest{
+		ProjectID: state.ProjectID.ValueString(),
+		TeamID:    state.TeamID.ValueString(),
+		ID:        state.ID.ValueString(),
+	})
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Deploy Hook",
+			fmt.Sprintf(
+				"Could not delete Deploy Hook %s %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.ProjectID.ValueString(),
+				state.ID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "deleted deploy hook", map[string]any{
+		"team_id":    state.TeamID.ValueString(),
+		"project_id": state.ProjectID.ValueString(),
+		"hook_id":    state.ID.ValueString(),
+	})
+}
+
+// ImportState takes an identifier and reads all the Deploy Hook information from the Vercel API.
+// The results are then stored in terraform state.
+func (r *projectDeployHookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, projectID, hookID, ok := splitInto2Or3(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing Deploy Hook",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/project_id/deploy_hook_id\" or \"project_id/deploy_hook_id\"", req.ID),
+		)
+		return
+	}
+
+	out, err := r.client.GetDeployHook(ctx, client.GetDeployHookRequest{
+		ProjectID: projectID,
+		TeamID:    teamID,
+		ID:        hookID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing Deploy Hook",
+			fmt.Sprintf("Could not get Deploy Hook %s %s %s, unexpected error: %s",
+				teamID,
+				projectID,
+				hookID,
+				err,
+			),
+		)
+		return
+	}
+
+	result := responseToProjectDeployHook(out, projectID, r.client.TeamID(teamID))
+	tflog.Info(ctx, "imported deploy hook", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"hook_id":    result.ID.ValueString(),
+	})
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
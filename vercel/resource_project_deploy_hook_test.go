@@ -0,0 +1,118 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+func testCheckProjectDeployHookExists(testClient *client.Client, teamID, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		_, err := testClient.GetDeployHook(context.TODO(), client.GetDeployHookRequest{
+			ProjectID: rs.Primary.Attributes["project_id"],
+			TeamID:    teamID,
+			ID:        rs.Primary.ID,
+		})
+		return err
+	}
+}
+
+func testCheckProjectDeployHookDeleted(testClient *client.Client, teamID, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		_, err := testClient.GetDeployHook(context.TODO(), client.GetDeployHookRequest{
+			ProjectID: rs.Primary.Attributes["project_id"],
+			TeamID:    teamID,
+			ID:        rs.Primary.ID,
+		})
+		if err == nil {
+			return fmt.Errorf("expected not_found error, but got no error")
+		}
+		if !client.NotFound(err) {
+			return fmt.Errorf("unexpected error checking for deleted deploy hook: %s", err)
+		}
+		return nil
+	}
+}
+
+func TestAcc_ProjectDeployHookResource(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testCheckProjectDeployHookDeleted(testClient(t), testTeam(t), "vercel_project_deploy_hook.test"),
+			testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccProjectDeployHookConfig(projectSuffix, testGithubRepo(t), "main")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckProjectDeployHookExists(testClient(t), testTeam(t), "vercel_project_deploy_hook.test"),
+					resource.TestCheckResourceAttr("vercel_project_deploy_hook.test", "name", "test hook"),
+					resource.TestCheckResourceAttr("vercel_project_deploy_hook.test", "ref", "main"),
+					resource.TestCheckResourceAttrSet("vercel_project_deploy_hook.test", "id"),
+					resource.TestCheckResourceAttrSet("vercel_project_deploy_hook.test", "url"),
+				),
+			},
+			{
+				ResourceName:      "vercel_project_deploy_hook.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["vercel_project_deploy_hook.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found")
+					}
+					return fmt.Sprintf("%s/%s/%s", testTeam(t), rs.Primary.Attributes["project_id"], rs.Primary.ID), nil
+				},
+			},
+			{
+				Config: cfg(testAccProjectDeployHookConfig(projectSuffix, testGithubRepo(t), "staging")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckProjectDeployHookExists(testClient(t), testTeam(t), "vercel_project_deploy_hook.test"),
+					resource.TestCheckResourceAttr("vercel_project_deploy_hook.test", "ref", "staging"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectDeployHookConfig(projectSuffix, githubRepo, ref string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-deploy-hook-%[1]s"
+  git_repository = {
+    type = "github"
+    repo = "%[2]s"
+  }
+}
+
+resource "vercel_project_deploy_hook" "test" {
+  project_id = vercel_project.test.id
+  name       = "test hook"
+  ref        = "%[3]s"
+}
+`, projectSuffix, githubRepo, ref)
+}
@@ -6,9 +6,12 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -60,7 +63,9 @@ Provides a Project Domain resource.
 
 A Project Domain is used to associate a domain name with a ` + "`vercel_project`." + `
 
-By default, Project Domains will be automatically applied to any ` + "`production` deployments.",
+By default, Project Domains will be automatically applied to any ` + "`production` deployments." + `
+
+If the domain is in use by another Vercel account, it must be verified before it can be used. The DNS records that verify it are exposed as ` + "`verification`" + `, and a ` + "`vercel_project_domain_verification`" + ` resource can wait for them to take effect.`,
 		Attributes: map[string]schema.Attribute{
 			"project_id": schema.StringAttribute{
 				Description:   "The project ID to add the deployment to.",
@@ -113,6 +118,36 @@ By default, Project Domains will be automatically applied to any ` + "`productio
 					),
 				},
 			},
+			"verified": schema.BoolAttribute{
+				Description:   "Whether the domain has been verified. A domain that is in use by another Vercel account must be verified with the `verification` records.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"verification": schema.ListNestedAttribute{
+				Description:   "The DNS records that must be added to verify the domain. Empty once the domain is verified.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "The type of the DNS record.",
+							Computed:    true,
+						},
+						"domain": schema.StringAttribute{
+							Description: "The name of the DNS record.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "The value of the DNS record.",
+							Computed:    true,
+						},
+						"reason": schema.StringAttribute{
+							Description: "Why the record is needed.",
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -127,10 +162,32 @@ type ProjectDomain struct {
 	Redirect            types.String `tfsdk:"redirect"`
 	RedirectStatusCode  types.Int64  `tfsdk:"redirect_status_code"`
 	TeamID              types.String `tfsdk:"team_id"`
+	Verified            types.Bool   `tfsdk:"verified"`
+	Verification        types.List   `tfsdk:"verification"`
+}
+
+var projectDomainVerificationAttrType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"type":   types.StringType,
+		"domain": types.StringType,
+		"value":  types.StringType,
+		"reason": types.StringType,
+	},
 }
 
 func convertResponseToProjectDomain(response client.ProjectDomainResponse) ProjectDomain {
+	verification := []attr.Value{}
+	for _, v := range response.Verification {
+		verification = append(verification, types.ObjectValueMust(projectDomainVerificationAttrType.AttrTypes, map[string]attr.Value{
+			"type":   types.StringValue(v.Type),
+			"domain": types.StringValue(v.Domain),
+			"value":  types.StringValue(v.Value),
+			"reason": types.StringValue(v.Reason),
+		}))
+	}
 	return ProjectDomain{
+		Verified:            types.BoolValue(response.Verified),
+		Verification:        types.ListValueMust(projectDomainVerificationAttrType, verification),
 		Domain:              types.StringValue(response.Name),
 		GitBranch:           types.StringPointerValue(response.GitBranch),
 		CustomEnvironmentID: types.StringPointerValue(response.CustomEnvironmentID),
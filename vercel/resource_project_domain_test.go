@@ -9,7 +9,10 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/vercel/terraform-provider-vercel/v3/client"
 )
 
@@ -40,6 +43,8 @@ func TestAcc_ProjectDomain(t *testing.T) {
 					testAccProjectDomainExists(testClient(t), "vercel_project.test", testTeam(t), "2"+domain),
 					testTeamID,
 					resource.TestCheckResourceAttr("vercel_project_domain.test", "domain", "2"+domain),
+					resource.TestCheckResourceAttr("vercel_project_domain.test", "verified", "true"),
+					resource.TestCheckResourceAttr("vercel_project_domain.test", "verification.#", "0"),
 				),
 			},
 			// Update testing
@@ -48,6 +53,13 @@ func TestAcc_ProjectDomain(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("vercel_project_domain.test", "redirect"),
 				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						// Verification is unaffected by an in-place update, so is kept from state.
+						plancheck.ExpectKnownValue("vercel_project_domain.test", tfjsonpath.New("verified"), knownvalue.Bool(true)),
+						plancheck.ExpectKnownValue("vercel_project_domain.test", tfjsonpath.New("verification"), knownvalue.ListSizeExact(0)),
+					},
+				},
 			},
 			// Redirect Update testing
 			{
@@ -0,0 +1,236 @@
+package vercel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+var (
+	_ resource.Resource              = &projectDomainVerificationResource{}
+	_ resource.ResourceWithConfigure = &projectDomainVerificationResource{}
+)
+
+func newProjectDomainVerificationResource() resource.Resource {
+	return &projectDomainVerificationResource{}
+}
+
+type projectDomainVerificationResource struct {
+	client *client.Client
+}
+
+func (r *projectDomainVerificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_domain_verification"
+}
+
+func (r *projectDomainVerificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema returns the schema information for a project domain verification resource.
+func (r *projectDomainVerificationResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Waits for a Project Domain to be verified.
+
+A domain that is in use by another Vercel account must be verified before it can be used by a project. The DNS records that verify it are exposed as the ` + "`verification`" + ` attribute of the ` + "`vercel_project_domain`" + `. Once those records have been created, for example with another DNS provider, this resource repeatedly asks Vercel to verify the domain until it succeeds, or until the ` + "`timeout`" + ` elapses.
+
+Creating the DNS records and waiting for verification can happen in the same apply, as long as this resource depends on the DNS records.
+
+If the domain later becomes unverified, the resource is removed from the state, so that the next apply waits for it again. Deleting this resource does nothing.
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description:   "The ID of the Project the domain is associated with.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseNonNullStateForUnknown()},
+				Description:   "The ID of the team the project exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"domain": schema.StringAttribute{
+				Description:   "The domain name to verify.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"timeout": schema.StringAttribute{
+				Description: "How long to wait for the domain to be verified, such as `30m`. Defaults to `10m`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("10m"),
+				Validators: []validator.String{
+					validateDuration(),
+				},
+			},
+			"verified": schema.BoolAttribute{
+				Description:   "Whether the domain has been verified.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// ProjectDomainVerification reflects the state terraform stores internally for a project domain verification.
+type ProjectDomainVerification struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	Domain    types.String `tfsdk:"domain"`
+	Timeout   types.String `tfsdk:"timeout"`
+	Verified  types.Bool   `tfsdk:"verified"`
+}
+
+// verify asks Vercel to verify the domain until it succeeds, or until the timeout elapses. Vercel responds
+// to a failed verification with a 400 error, which means the records have not taken effect yet.
+func (r *projectDomainVerificationResource) verify(ctx context.Context, plan ProjectDomainVerification) (client.ProjectDomainResponse, error) {
+	projectID, domain, teamID := plan.ProjectID.ValueString(), plan.Domain.ValueString(), plan.TeamID.ValueString()
+	out, err := r.client.GetProjectDomain(ctx, projectID, domain, teamID)
+	if err != nil || out.Verified {
+		return out, err
+	}
+
+	timeout, _ := time.ParseDuration(plan.Timeout.ValueString())
+	var lastErr error
+	err = pollUntil(ctx, timeout, 15*time.Second, func(ctx context.Context) (bool, error) {
+		out, lastErr = r.client.VerifyProjectDomain(ctx, projectID, domain, teamID)
+		var apiErr client.APIError
+		if errors.As(lastErr, &apiErr) && apiErr.StatusCode == http.StatusBadRequest {
+			tflog.Info(ctx, "project domain not yet verified", map[string]any{
+				"domain": domain,
+				"error":  lastErr.Error(),
+			})
+			return false, nil
+		}
+		return lastErr == nil && out.Verified, lastErr
+	})
+	if err != nil && lastErr != nil && !errors.Is(lastErr, context.DeadlineExceeded) {
+		return out, fmt.Errorf("%w: %s", err, lastErr)
+	}
+	return out, err
+}
+
+// Create waits for the project domain to be verified.
+// This is called automatically by the provider when a new resource should be created.
+func (r *projectDomainVerificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectDomainVerification
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.verify(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error verifying project domain",
+			fmt.Sprintf(
+				"Could not verify domain %s for project %s: %s",
+				plan.Domain.ValueString(),
+				plan.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result := ProjectDomainVerification{
+		ProjectID: plan.ProjectID,
+		TeamID:    toTeamID(out.TeamID),
+		Domain:    plan.Domain,
+		Timeout:   plan.Timeout,
+		Verified:  types.BoolValue(out.Verified),
+	}
+	tflog.Info(ctx, "verified project domain", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"domain":     result.Domain.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read checks the project domain is still verified, and removes the resource from state if it is not.
+func (r *projectDomainVerificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectDomainVerification
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetProjectDomain(ctx, state.ProjectID.ValueString(), state.Domain.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading project domain",
+			fmt.Sprintf("Could not get domain %s for project %s, unexpected error: %s",
+				state.Domain.ValueString(),
+				state.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+	if !out.Verified {
+		tflog.Info(ctx, "project domain no longer verified", map[string]any{
+			"project_id": state.ProjectID.ValueString(),
+			"domain":     state.Domain.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only changes the timeout, as every other attribute requires replacement.
+func (r *projectDomainVerificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ProjectDomainVerification
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete does nothing, as verification cannot be undone.
+func (r *projectDomainVerificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
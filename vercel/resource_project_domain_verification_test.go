@@ -0,0 +1,43 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ProjectDomainVerification(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	domain := acctest.RandString(30) + ".vercel.app"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             noopDestroyCheck,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-domain-%s"
+}
+
+resource "vercel_project_domain" "test" {
+  domain     = "%s"
+  project_id = vercel_project.test.id
+}
+
+resource "vercel_project_domain_verification" "test" {
+  domain     = vercel_project_domain.test.domain
+  project_id = vercel_project.test.id
+  timeout    = "1m"
+}
+`, projectSuffix, domain)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_project_domain_verification.test", "verified", "true"),
+					resource.TestCheckResourceAttr("vercel_project_domain_verification.test", "timeout", "1m"),
+				),
+			},
+		},
+	})
+}
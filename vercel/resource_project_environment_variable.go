@@ -3,7 +3,10 @@ package vercel
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -108,9 +111,28 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 				Description:   "The name of the Environment Variable.",
 			},
 			"value": schema.StringAttribute{
-				Required:    true,
-				Description: "The value of the Environment Variable.",
+				Optional:    true,
+				Description: "The value of the Environment Variable. Exactly one of `value` or `value_wo` must be set.",
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("value"),
+						path.MatchRoot("value_wo"),
+					),
+				},
+			},
+			"value_wo": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
 				Sensitive:   true,
+				Description: "The value of the Environment Variable, as a write-only attribute that is never stored in the plan or state. Terraform cannot detect changes to a write-only value, so `value_wo_version` must be changed for a new value to be applied. Requires Terraform 1.11 or later. Exactly one of `value` or `value_wo` must be set.",
+			},
+			"value_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The version of `value_wo`. Change this to update the Environment Variable with the current `value_wo`.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("value_wo")),
+				},
 			},
 			"git_branch": schema.StringAttribute{
 				Optional:    true,
@@ -147,6 +169,10 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 					stringvalidator.LengthBetween(0, 1000),
 				},
 			},
+			"adopt_conflicting": schema.BoolAttribute{
+				Description: "Whether to adopt an existing Environment Variable that conflicts with this one, rather than failing to create it. Environment Variables conflict when they have the same key and git branch, and are present on any of the same environments. The adopted Environment Variable is updated to match the configuration.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -158,11 +184,14 @@ type ProjectEnvironmentVariable struct {
 	GitBranch            types.String `tfsdk:"git_branch"`
 	Key                  types.String `tfsdk:"key"`
 	Value                types.String `tfsdk:"value"`
+	ValueWO              types.String `tfsdk:"value_wo"`
+	ValueWOVersion       types.Int64  `tfsdk:"value_wo_version"`
 	TeamID               types.String `tfsdk:"team_id"`
 	ProjectID            types.String `tfsdk:"project_id"`
 	ID                   types.String `tfsdk:"id"`
 	Sensitive            types.Bool   `tfsdk:"sensitive"`
 	Comment              types.String `tfsdk:"comment"`
+	AdoptConflicting     types.Bool   `tfsdk:"adopt_conflicting"`
 }
 
 func (r *projectEnvironmentVariableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -176,6 +205,11 @@ func (r *projectEnvironmentVariableResource) ModifyPlan(ctx context.Context, req
 		return
 	}
 
+	r.modifyPlanForConflicts(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if config.ID.ValueString() != "" {
 		// The resource already exists, so this is okay.
 		return
@@ -208,6 +242,118 @@ func (r *projectEnvironmentVariableResource) ModifyPlan(ctx context.Context, req
 	)
 }
 
+// modifyPlanForConflicts detects any existing environment variables that conflict with the planned
+// environment variable, so they can be reported in the plan rather than failing the apply. If the
+// conflicting environment variable should be adopted, its ID is added to the plan.
+func (r *projectEnvironmentVariableResource) modifyPlanForConflicts(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan ProjectEnvironmentVariable
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.ProjectID.IsUnknown() || plan.Key.IsUnknown() || plan.GitBranch.IsUnknown() || (plan.Target.IsUnknown() && plan.CustomEnvironmentIDs.IsUnknown()) {
+		// The project may not exist yet, or the environments are not known, so conflicts can't be detected.
+		return
+	}
+
+	var target, customEnvironmentIDs []string
+	if !plan.Target.IsUnknown() {
+		resp.Diagnostics.Append(plan.Target.ElementsAs(ctx, &target, true)...)
+	}
+	if !plan.CustomEnvironmentIDs.IsUnknown() {
+		resp.Diagnostics.Append(plan.CustomEnvironmentIDs.ElementsAs(ctx, &customEnvironmentIDs, true)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creating := req.State.Raw.IsNull()
+	var state ProjectEnvironmentVariable
+	if !creating {
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		var stateTarget, stateCustomEnvironmentIDs []string
+		resp.Diagnostics.Append(state.Target.ElementsAs(ctx, &stateTarget, true)...)
+		resp.Diagnostics.Append(state.CustomEnvironmentIDs.ElementsAs(ctx, &stateCustomEnvironmentIDs, true)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if isSameStringSet(target, stateTarget) && isSameStringSet(customEnvironmentIDs, stateCustomEnvironmentIDs) && plan.GitBranch.Equal(state.GitBranch) {
+			// Nothing has changed that could cause a conflict.
+			return
+		}
+	}
+
+	envs, err := r.client.ListEnvironmentVariables(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString())
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error validating project environment variable",
+			"Could not read project environment variables to detect conflicts, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	envs = slices.DeleteFunc(envs, func(e client.EnvironmentVariable) bool {
+		return e.ID == state.ID.ValueString()
+	})
+
+	conflicts := client.FindConflictingEnvironmentVariables(envs, plan.Key.ValueString(), target, customEnvironmentIDs, plan.GitBranch.ValueStringPointer())
+	if len(conflicts) == 0 {
+		return
+	}
+	if creating && plan.AdoptConflicting.ValueBool() && len(conflicts) > 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("adopt_conflicting"),
+			"Conflicting Project Environment Variables",
+			fmt.Sprintf("The Environment Variable %s conflicts with %d existing Environment Variables, so it cannot adopt them. Remove all but one of the conflicting Environment Variables, or change the `target` or `git_branch` of this Environment Variable.", plan.Key.ValueString(), len(conflicts)),
+		)
+		return
+	}
+
+	for _, c := range conflicts {
+		gitBranch := "none"
+		if c.GitBranch != nil {
+			gitBranch = *c.GitBranch
+		}
+		environments := slices.Concat(c.Target, c.CustomEnvironmentIDs)
+		detail := fmt.Sprintf(
+			"An existing Environment Variable conflicts with this one.\n\nID: %s\nKey: %s\nTarget: %s\nGit branch: %s\n\n",
+			c.ID,
+			c.Key,
+			strings.Join(environments, ", "),
+			gitBranch,
+		)
+		switch {
+		case creating && plan.AdoptConflicting.ValueBool():
+			detail += "As `adopt_conflicting` is enabled, the existing Environment Variable will be adopted and updated to match the configuration."
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringValue(c.ID))...)
+		case creating:
+			importID := fmt.Sprintf("%s/%s", plan.ProjectID.ValueString(), c.ID)
+			if teamID := r.client.TeamID(plan.TeamID.ValueString()); teamID != "" {
+				importID = fmt.Sprintf("%s/%s", teamID, importID)
+			}
+			detail += fmt.Sprintf("Creating this Environment Variable will fail. Set `adopt_conflicting = true` to adopt the existing Environment Variable, import it with the ID `%s`, or remove it.", importID)
+		default:
+			detail += "Updating this Environment Variable will fail until the conflicting Environment Variable is removed."
+		}
+		resp.Diagnostics.AddAttributeWarning(path.Root("key"), "Conflicting Project Environment Variable", detail)
+	}
+}
+
+// requestValue returns the value to send to the API, which is either the value or the write-only value.
+func (e *ProjectEnvironmentVariable) requestValue() string {
+	if e.Value.IsNull() {
+		return e.ValueWO.ValueString()
+	}
+	return e.Value.ValueString()
+}
+
 func (e *ProjectEnvironmentVariable) toCreateEnvironmentVariableRequest(ctx context.Context) (req client.CreateEnvironmentVariableRequest, diags diag.Diagnostics) {
 	var target []string
 	diags = e.Target.ElementsAs(ctx, &target, true)
@@ -229,7 +375,7 @@ func (e *ProjectEnvironmentVariable) toCreateEnvironmentVariableRequest(ctx cont
 	return client.CreateEnvironmentVariableRequest{
 		EnvironmentVariable: client.EnvironmentVariableRequest{
 			Key:                  e.Key.ValueString(),
-			Value:                e.Value.ValueString(),
+			Value:                e.requestValue(),
 			Target:               target,
 			CustomEnvironmentIDs: customEnvironmentIDs,
 			GitBranch:            e.GitBranch.ValueStringPointer(),
@@ -261,7 +407,7 @@ func (e *ProjectEnvironmentVariable) toUpdateEnvironmentVariableRequest(ctx cont
 	}
 
 	return client.UpdateEnvironmentVariableRequest{
-		Value:                e.Value.ValueString(),
+		Value:                e.requestValue(),
 		Target:               target,
 		CustomEnvironmentIDs: customEnvironmentIDs,
 		GitBranch:            e.GitBranch.ValueStringPointer(),
@@ -306,6 +452,14 @@ func convertResponseToProjectEnvironmentVariable(response client.EnvironmentVari
 	}
 }
 
+// withWriteOnlyValue keeps the value out of state if the environment variable uses a write-only value.
+func (e *ProjectEnvironmentVariable) withWriteOnlyValue(prior ProjectEnvironmentVariable) {
+	e.ValueWOVersion = prior.ValueWOVersion
+	if prior.Value.IsNull() {
+		e.Value = types.StringNull()
+	}
+}
+
 // Create will create a new project environment variable for a Vercel project.
 // This is called automatically by the provider when a new resource should be created.
 func (r *projectEnvironmentVariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -316,6 +470,12 @@ func (r *projectEnvironmentVariableResource) Create(ctx context.Context, req res
 		return
 	}
 
+	diags = req.Config.GetAttribute(ctx, path.Root("value_wo"), &plan.ValueWO)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.GetProject(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString())
 	if client.NotFound(err) {
 		resp.Diagnostics.AddError(
@@ -325,12 +485,23 @@ func (r *projectEnvironmentVariableResource) Create(ctx context.Context, req res
 		return
 	}
 
-	request, diags := plan.toCreateEnvironmentVariableRequest(ctx)
-	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
-		return
+	var response client.EnvironmentVariable
+	if plan.ID.ValueString() != "" {
+		// The plan adopts a conflicting environment variable, so update it rather than creating a new one.
+		request, diags := plan.toUpdateEnvironmentVariableRequest(ctx)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		response, err = r.client.UpdateEnvironmentVariable(ctx, request)
+	} else {
+		request, diags := plan.toCreateEnvironmentVariableRequest(ctx)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		response, err = r.client.CreateEnvironmentVariable(ctx, request)
 	}
-	response, err := r.client.CreateEnvironmentVariable(ctx, request)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating project environment variable",
@@ -340,6 +511,8 @@ func (r *projectEnvironmentVariableResource) Create(ctx context.Context, req res
 	}
 
 	result := convertResponseToProjectEnvironmentVariable(response, plan.ProjectID, plan.Value)
+	result.withWriteOnlyValue(plan)
+	result.AdoptConflicting = plan.AdoptConflicting
 
 	tflog.Info(ctx, "created project environment variable", map[string]any{
 		"id":         result.ID.ValueString(),
@@ -383,6 +556,8 @@ func (r *projectEnvironmentVariableResource) Read(ctx context.Context, req resou
 	}
 
 	result := convertResponseToProjectEnvironmentVariable(out, state.ProjectID, state.Value)
+	result.withWriteOnlyValue(state)
+	result.AdoptConflicting = state.AdoptConflicting
 	tflog.Info(ctx, "read project environment variable", map[string]any{
 		"id":         result.ID.ValueString(),
 		"team_id":    result.TeamID.ValueString(),
@@ -405,6 +580,12 @@ func (r *projectEnvironmentVariableResource) Update(ctx context.Context, req res
 		return
 	}
 
+	diags = req.Config.GetAttribute(ctx, path.Root("value_wo"), &plan.ValueWO)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	request, diags := plan.toUpdateEnvironmentVariableRequest(ctx)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
@@ -420,6 +601,8 @@ func (r *projectEnvironmentVariableResource) Update(ctx context.Context, req res
 	}
 
 	result := convertResponseToProjectEnvironmentVariable(response, plan.ProjectID, plan.Value)
+	result.withWriteOnlyValue(plan)
+	result.AdoptConflicting = plan.AdoptConflicting
 
 	tflog.Info(ctx, "updated project environment variable", map[string]any{
 		"id":         result.ID.ValueString(),
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/vercel/terraform-provider-vercel/v3/client"
 )
 
@@ -132,6 +133,89 @@ func TestAcc_ProjectEnvironmentVariable(t *testing.T) {
 	})
 }
 
+func testAccProjectEnvironmentVariableValue(testClient *client.Client, n, teamID, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		env, err := testClient.GetEnvironmentVariable(context.TODO(), rs.Primary.Attributes["project_id"], teamID, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if env.Value != want {
+			return fmt.Errorf("expected environment variable value %q, but got %q", want, env.Value)
+		}
+		return nil
+	}
+}
+
+func TestAcc_ProjectEnvironmentVariableWriteOnly(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.example", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccProjectEnvironmentVariableConfigWriteOnly(nameSuffix, "bar", 1)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectEnvironmentVariableExists(testClient(t), "vercel_project_environment_variable.example", testTeam(t)),
+					testAccProjectEnvironmentVariableValue(testClient(t), "vercel_project_environment_variable.example", testTeam(t), "bar"),
+					resource.TestCheckNoResourceAttr("vercel_project_environment_variable.example", "value"),
+					resource.TestCheckNoResourceAttr("vercel_project_environment_variable.example", "value_wo"),
+					resource.TestCheckResourceAttr("vercel_project_environment_variable.example", "value_wo_version", "1"),
+				),
+			},
+			{
+				// Changing the value without changing the version should not update the value.
+				Config:   cfg(testAccProjectEnvironmentVariableConfigWriteOnly(nameSuffix, "baz", 1)),
+				PlanOnly: true,
+			},
+			{
+				Config: cfg(testAccProjectEnvironmentVariableConfigWriteOnly(nameSuffix, "baz", 2)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectEnvironmentVariableValue(testClient(t), "vercel_project_environment_variable.example", testTeam(t), "baz"),
+					resource.TestCheckNoResourceAttr("vercel_project_environment_variable.example", "value"),
+					resource.TestCheckResourceAttr("vercel_project_environment_variable.example", "value_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectEnvironmentVariableAdoptConflicting(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.example", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccProjectEnvironmentVariableConfigAdoptConflicting(nameSuffix, false)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Add a conflicting env var outside of Terraform.
+					testAccProjectEnvironmentVariablesCreateUnmanaged(testClient(t), "vercel_project.example", testTeam(t), "CONFLICTING"),
+				),
+			},
+			{
+				Config: cfg(testAccProjectEnvironmentVariableConfigAdoptConflicting(nameSuffix, true)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectEnvironmentVariableExists(testClient(t), "vercel_project_environment_variable.example", testTeam(t)),
+					testAccProjectEnvironmentVariableValue(testClient(t), "vercel_project_environment_variable.example", testTeam(t), "adopted"),
+					resource.TestCheckResourceAttr("vercel_project_environment_variable.example", "adopt_conflicting", "true"),
+				),
+			},
+		},
+	})
+}
+
 func getProjectEnvironmentVariableImportID(n string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[n]
@@ -244,3 +328,41 @@ resource "vercel_project" "example" {
 }
 `, projectName, githubRepo)
 }
+
+func testAccProjectEnvironmentVariableConfigWriteOnly(projectName, value string, version int) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "example" {
+    name = "test-acc-example-project-%[1]s"
+}
+
+resource "vercel_project_environment_variable" "example" {
+    project_id       = vercel_project.example.id
+    key              = "foo"
+    value_wo         = "%[2]s"
+    value_wo_version = %[3]d
+    target           = ["production"]
+}
+`, projectName, value, version)
+}
+
+func testAccProjectEnvironmentVariableConfigAdoptConflicting(projectName string, withEnv bool) string {
+	env := ""
+	if withEnv {
+		env = `
+resource "vercel_project_environment_variable" "example" {
+  project_id        = vercel_project.example.id
+  key               = "CONFLICTING"
+  value             = "adopted"
+  target            = ["production"]
+  sensitive         = false
+  adopt_conflicting = true
+}
+`
+	}
+	return fmt.Sprintf(`
+resource "vercel_project" "example" {
+  name = "test-acc-example-project-%[1]s"
+}
+%[2]s
+`, projectName, env)
+}
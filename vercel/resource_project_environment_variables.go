@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -79,6 +81,8 @@ For more detailed information, please see the [Vercel documentation](https://ver
 
 ~> Terraform currently provides this Project Environment Variables resource (multiple Environment Variables), a single Project Environment Variable Resource, and a Project resource with Environment Variables defined in-line via the ` + "`environment` field" + `.
 At this time you cannot use a Vercel Project resource with in-line ` + "`environment` in conjunction with any `vercel_project_environment_variables` or `vercel_project_environment_variable`" + ` resources. Doing so will cause a conflict of settings and will overwrite Environment Variables.
+
+~> When ` + "`exclusive`" + ` is enabled, this resource manages every Environment Variable on the project. Any Environment Variable that is not present in ` + "`variables`, and does not match `ignore_keys`" + `, will be removed.
 `,
 		Attributes: map[string]schema.Attribute{
 			"project_id": schema.StringAttribute{
@@ -92,6 +96,34 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 				Description:   "The ID of the Vercel team. Required when configuring a team resource if a default team has not been set in the provider.",
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseNonNullStateForUnknown()},
 			},
+			"values_wo": schema.MapAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "Values for any `variables` that do not set a `value`, keyed by the Environment Variable key. As there is one value for each key, only one Environment Variable with each key can omit `value`. To use different write-only values for the same key across targets or branches, use a `vercel_project_environment_variable` resource with `value_wo` for each of them. This is a write-only attribute, so the values are never stored in the plan or state. Terraform cannot detect changes to a write-only value, so `values_wo_version` must be changed for new values to be applied. Requires Terraform 1.11 or later.",
+			},
+			"values_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The version of `values_wo`. Change this to update every Environment Variable that takes its value from `values_wo`.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("values_wo")),
+				},
+			},
+			"exclusive": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether this resource should manage every Environment Variable on the project. When enabled, any Environment Variable that is not present in `variables` is shown as a planned deletion. System Environment Variables are never removed.",
+			},
+			"ignore_keys": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Glob patterns for the keys of Environment Variables that should be left alone when `exclusive` is enabled, such as `SENTRY_*` for Environment Variables added by an integration.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validateGlobPattern()),
+				},
+			},
 			"variables": schema.SetNestedAttribute{
 				Required:    true,
 				Description: "A set of Environment Variables that should be configured for the project.",
@@ -106,8 +138,8 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 							Description: "The name of the Environment Variable.",
 						},
 						"value": schema.StringAttribute{
-							Required:    true,
-							Description: "The value of the Environment Variable.",
+							Optional:    true,
+							Description: "The value of the Environment Variable. If omitted, the value is taken from `values_wo` using the key of the Environment Variable.",
 							Sensitive:   true,
 						},
 						"target": schema.SetAttribute{
@@ -164,9 +196,13 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 
 // ProjectEnvironmentVariables reflects the state terraform stores internally for project environment variables.
 type ProjectEnvironmentVariables struct {
-	TeamID    types.String `tfsdk:"team_id"`
-	ProjectID types.String `tfsdk:"project_id"`
-	Variables types.Set    `tfsdk:"variables"`
+	TeamID          types.String `tfsdk:"team_id"`
+	ProjectID       types.String `tfsdk:"project_id"`
+	ValuesWO        types.Map    `tfsdk:"values_wo"`
+	ValuesWOVersion types.Int64  `tfsdk:"values_wo_version"`
+	Exclusive       types.Bool   `tfsdk:"exclusive"`
+	IgnoreKeys      types.Set    `tfsdk:"ignore_keys"`
+	Variables       types.Set    `tfsdk:"variables"`
 }
 
 func (p *ProjectEnvironmentVariables) environment(ctx context.Context) (EnvironmentItems, diag.Diagnostics) {
@@ -201,6 +237,12 @@ func (r *projectEnvironmentVariablesResource) ModifyPlan(ctx context.Context, re
 		return
 	}
 
+	diags = validateWriteOnlyEnvironmentValues(ctx, req.Config, environment, config.Variables.Elements(), path.Root("variables"), path.Root("values_wo"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// work out if there are any new env vars that are specifying sensitive = false
 	var nonSensitiveEnvVars []path.Path
 	for i, e := range environment {
@@ -249,7 +291,7 @@ func (r *projectEnvironmentVariablesResource) ModifyPlan(ctx context.Context, re
 
 type EnvironmentItems []EnvironmentItem
 
-func (e *EnvironmentItems) toCreateEnvironmentVariablesRequest(ctx context.Context, projectID types.String, teamID types.String) (r client.CreateEnvironmentVariablesRequest, diags diag.Diagnostics) {
+func (e *EnvironmentItems) toCreateEnvironmentVariablesRequest(ctx context.Context, projectID types.String, teamID types.String, writeOnlyValues map[string]string) (r client.CreateEnvironmentVariablesRequest, diags diag.Diagnostics) {
 	variables := []client.EnvironmentVariableRequest{}
 	for _, env := range *e {
 		var target []string
@@ -270,7 +312,7 @@ func (e *EnvironmentItems) toCreateEnvironmentVariablesRequest(ctx context.Conte
 		}
 		variables = append(variables, client.EnvironmentVariableRequest{
 			Key:                  env.Key.ValueString(),
-			Value:                env.Value.ValueString(),
+			Value:                env.requestValue(writeOnlyValues),
 			Target:               target,
 			CustomEnvironmentIDs: customEnvironmentIDs,
 			Type:                 envVariableType,
@@ -328,22 +370,24 @@ func convertResponseToProjectEnvironmentVariables(
 		if e.Type == "sensitive" {
 			value = types.StringNull()
 		}
-		if e.Decrypted != nil && !*e.Decrypted || e.Type == "sensitive" {
-			for _, p := range environment {
-				var target []string
-				diags := p.Target.ElementsAs(ctx, &target, true)
-				if diags.HasError() {
-					return ProjectEnvironmentVariables{}, diags
-				}
-				var customEnvironmentIDs []string
-				diags = p.CustomEnvironmentIDs.ElementsAs(ctx, &customEnvironmentIDs, true)
-				if diags.HasError() {
-					return ProjectEnvironmentVariables{}, diags
-				}
-				if p.Key.ValueString() == e.Key && isSameStringSet(target, e.Target) && isSameStringSet(customEnvironmentIDs, e.CustomEnvironmentIDs) && strPtrEqual(p.GitBranch.ValueStringPointer(), e.GitBranch) {
+		for _, p := range environment {
+			var target []string
+			diags := p.Target.ElementsAs(ctx, &target, true)
+			if diags.HasError() {
+				return ProjectEnvironmentVariables{}, diags
+			}
+			var customEnvironmentIDs []string
+			diags = p.CustomEnvironmentIDs.ElementsAs(ctx, &customEnvironmentIDs, true)
+			if diags.HasError() {
+				return ProjectEnvironmentVariables{}, diags
+			}
+			if p.Key.ValueString() == e.Key && isSameStringSet(target, e.Target) && isSameStringSet(customEnvironmentIDs, e.CustomEnvironmentIDs) && strPtrEqual(p.GitBranch.ValueStringPointer(), e.GitBranch) {
+				// Use the planned value if the API can't return the value, or if the value is
+				// write-only and so must not be stored in state.
+				if e.Decrypted != nil && !*e.Decrypted || e.Type == "sensitive" || p.Value.IsNull() {
 					value = p.Value
-					break
 				}
+				break
 			}
 		}
 
@@ -373,9 +417,13 @@ func convertResponseToProjectEnvironmentVariables(
 	}
 
 	return ProjectEnvironmentVariables{
-		TeamID:    toTeamID(plan.TeamID.ValueString()),
-		ProjectID: plan.ProjectID,
-		Variables: types.SetValueMust(envVariableElemType, env),
+		TeamID:          toTeamID(plan.TeamID.ValueString()),
+		ProjectID:       plan.ProjectID,
+		ValuesWO:        types.MapNull(types.StringType),
+		ValuesWOVersion: plan.ValuesWOVersion,
+		Exclusive:       plan.Exclusive,
+		IgnoreKeys:      plan.IgnoreKeys,
+		Variables:       types.SetValueMust(envVariableElemType, env),
 	}, nil
 }
 
@@ -404,7 +452,13 @@ func (r *projectEnvironmentVariablesResource) Create(ctx context.Context, req re
 		return
 	}
 
-	request, diags := envs.toCreateEnvironmentVariablesRequest(ctx, plan.ProjectID, plan.TeamID)
+	writeOnlyValues, diags := writeOnlyEnvironmentValues(ctx, req.Config, path.Root("values_wo"))
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	request, diags := envs.toCreateEnvironmentVariablesRequest(ctx, plan.ProjectID, plan.TeamID, writeOnlyValues)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -458,10 +512,17 @@ func (r *projectEnvironmentVariablesResource) Read(ctx context.Context, req reso
 			existingIDs[e.ID.ValueString()] = struct{}{}
 		}
 	}
-	if len(existingIDs) == 0 {
+	exclusive := state.Exclusive.ValueBool()
+	if len(existingIDs) == 0 && !exclusive {
 		// no existing environment variables, nothing to do
 		return
 	}
+	var ignoreKeys []string
+	diags = state.IgnoreKeys.ElementsAs(ctx, &ignoreKeys, true)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
 
 	envs, err := r.client.GetEnvironmentVariables(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString())
 	if client.NotFound(err) {
@@ -485,6 +546,12 @@ func (r *projectEnvironmentVariablesResource) Read(ctx context.Context, req reso
 	}
 	for _, e := range envs {
 		if _, ok := existingIDs[e.ID]; !ok {
+			if exclusive && e.Type != "system" && !matchesAnyGlob(ignoreKeys, e.Key) {
+				// In exclusive mode every env var on the project is managed, so include it in state.
+				// As it isn't in the config, it will be planned for deletion.
+				toUse = append(toUse, e)
+				continue
+			}
 			// The env var exists at the moment, but not in TF state (the ID isn't present).
 			// Check if it has the same `key`, `target` and `custom_environment_ids` as an existing env var.
 			// This detects drift for stuff like: deleting an env var and then creating it again (the ID changes).
@@ -562,6 +629,10 @@ func (r *projectEnvironmentVariablesResource) Update(ctx context.Context, req re
 		}
 	}
 
+	// Environment variables using write-only values are re-created when the version changes, as
+	// there is no other way to tell whether their value has changed.
+	rotate := !plan.ValuesWOVersion.Equal(state.ValuesWOVersion)
+
 	var toRemove EnvironmentItems
 	var unchanged EnvironmentItems
 	for _, e := range stateEnvs {
@@ -570,7 +641,7 @@ func (r *projectEnvironmentVariablesResource) Update(ctx context.Context, req re
 			toRemove = append(toRemove, e)
 			continue
 		}
-		if !plannedEnv.equal(&e) {
+		if !plannedEnv.equal(&e) || (rotate && plannedEnv.Value.IsNull()) {
 			toRemove = append(toRemove, e)
 			toAdd = append(toAdd, plannedEnv)
 			continue
@@ -590,8 +661,17 @@ func (r *projectEnvironmentVariablesResource) Update(ctx context.Context, req re
 		)
 		return
 	}
+	removing := map[string]bool{}
+	for _, e := range toRemove {
+		removing[e.ID.ValueString()] = true
+	}
 	skipAdding := map[int]bool{}
 	for _, e := range envsFromAPI {
+		if removing[e.ID] {
+			// The env var is about to be removed, for example as it is no longer managed in
+			// exclusive mode, so it can't be reused.
+			continue
+		}
 		// The env var exists at the moment, but not in TF state (the ID isn't present).
 		// Check if it has the same `key`, `target` and `custom_environment_ids` and value as any env var we are adding.
 		// This detects drift for stuff like: deleting an env var and then creating it again (the ID changes, but
@@ -617,6 +697,9 @@ func (r *projectEnvironmentVariablesResource) Update(ctx context.Context, req re
 					if e.Type == "sensitive" {
 						continue // We don't know if it's the same env var if sensitive
 					}
+					if ee.Value.IsNull() {
+						continue // The value is write-only, so it can't be compared.
+					}
 					if e.Value != ee.Value.ValueString() {
 						continue // Value mismatches, so we need to update it.
 					}
@@ -703,7 +786,12 @@ func (r *projectEnvironmentVariablesResource) Update(ctx context.Context, req re
 			// This is disgusting, but what you gonna do?
 			time.Sleep(time.Second * 5)
 		}
-		request, diags := toAdd.toCreateEnvironmentVariablesRequest(ctx, plan.ProjectID, plan.TeamID)
+		writeOnlyValues, diags := writeOnlyEnvironmentValues(ctx, req.Config, path.Root("values_wo"))
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		request, diags := toAdd.toCreateEnvironmentVariablesRequest(ctx, plan.ProjectID, plan.TeamID, writeOnlyValues)
 
 		if diags.HasError() {
 			resp.Diagnostics.Append(diags...)
@@ -1,11 +1,16 @@
 package vercel_test
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
 )
 
 func TestAcc_ProjectEnvironmentVariables(t *testing.T) {
@@ -134,3 +139,169 @@ resource "vercel_project_environment_variables" "test" {
 }
 `, projectName, githubRepo)
 }
+
+func testAccProjectEnvironmentVariablesCreateUnmanaged(testClient *client.Client, n, teamID string, keys ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		for _, key := range keys {
+			_, err := testClient.CreateEnvironmentVariable(context.TODO(), client.CreateEnvironmentVariableRequest{
+				ProjectID: rs.Primary.ID,
+				TeamID:    teamID,
+				EnvironmentVariable: client.EnvironmentVariableRequest{
+					Key:    key,
+					Value:  "unmanaged",
+					Target: []string{"production"},
+					Type:   "encrypted",
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("error creating environment variable %s: %w", key, err)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccProjectEnvironmentVariablesKeyExists(testClient *client.Client, n, teamID, key string) resource.TestCheckFunc {
+	return testAccProjectEnvironmentVariablesKey(testClient, n, teamID, key, true)
+}
+
+func testAccProjectEnvironmentVariablesKeyDoesNotExist(testClient *client.Client, n, teamID, key string) resource.TestCheckFunc {
+	return testAccProjectEnvironmentVariablesKey(testClient, n, teamID, key, false)
+}
+
+func testAccProjectEnvironmentVariablesKey(testClient *client.Client, n, teamID, key string, want bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		envs, err := testClient.GetEnvironmentVariables(context.TODO(), rs.Primary.ID, teamID)
+		if err != nil {
+			return err
+		}
+		exists := false
+		for _, e := range envs {
+			if e.Key == key {
+				exists = true
+			}
+		}
+		if exists != want {
+			if want {
+				return fmt.Errorf("expected environment variable %s to exist", key)
+			}
+			return fmt.Errorf("expected environment variable %s to have been deleted", key)
+		}
+		return nil
+	}
+}
+
+func TestAcc_ProjectEnvironmentVariablesExclusive(t *testing.T) {
+	projectName := "test-acc-example-env-vars-" + acctest.RandString(16)
+	resourceName := "vercel_project_environment_variables.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccProjectEnvironmentVariablesConfigExclusive(projectName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "exclusive", "true"),
+					resource.TestCheckResourceAttr(resourceName, "variables.#", "1"),
+					// Add env vars outside of Terraform, which should be detected as drift.
+					testAccProjectEnvironmentVariablesCreateUnmanaged(testClient(t), "vercel_project.test", testTeam(t), "UNMANAGED_VAR", "IGNORED_VAR"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The unmanaged env var is drift, so it should be planned for deletion.
+				Config:             cfg(testAccProjectEnvironmentVariablesConfigExclusive(projectName)),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: cfg(testAccProjectEnvironmentVariablesConfigExclusive(projectName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "variables.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "variables.*", map[string]string{
+						"key": "TEST_VAR_1",
+					}),
+					testAccProjectEnvironmentVariablesKeyDoesNotExist(testClient(t), "vercel_project.test", testTeam(t), "UNMANAGED_VAR"),
+					testAccProjectEnvironmentVariablesKeyExists(testClient(t), "vercel_project.test", testTeam(t), "IGNORED_VAR"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectEnvironmentVariablesConfigExclusive(projectName string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "%s"
+}
+
+resource "vercel_project_environment_variables" "test" {
+  project_id  = vercel_project.test.id
+  exclusive   = true
+  ignore_keys = ["IGNORED_*"]
+  variables = [
+    {
+      key    = "TEST_VAR_1"
+      value  = "test_value_1"
+      target = ["production", "preview"]
+    }
+  ]
+}
+`, projectName)
+}
+
+func TestAcc_ProjectEnvironmentVariablesWriteOnlyDuplicateKey(t *testing.T) {
+	projectName := "test-acc-example-env-vars-" + acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      cfg(testAccProjectEnvironmentVariablesConfigWriteOnlyDuplicateKey(projectName)),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`There\s+are\s+2\s+Environment\s+Variables\s+with\s+the\s+key\s+TEST_VAR_1`),
+			},
+		},
+	})
+}
+
+func testAccProjectEnvironmentVariablesConfigWriteOnlyDuplicateKey(projectName string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "%s"
+}
+
+resource "vercel_project_environment_variables" "test" {
+  project_id = vercel_project.test.id
+  values_wo = {
+    TEST_VAR_1 = "secret"
+  }
+  variables = [
+    {
+      key    = "TEST_VAR_1"
+      target = ["production"]
+    },
+    {
+      key    = "TEST_VAR_1"
+      target = ["preview"]
+    }
+  ]
+}
+`, projectName)
+}
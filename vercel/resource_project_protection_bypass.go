@@ -0,0 +1,343 @@
+package vercel
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &projectProtectionBypassResource{}
+	_ resource.ResourceWithConfigure   = &projectProtectionBypassResource{}
+	_ resource.ResourceWithImportState = &projectProtectionBypassResource{}
+)
+
+func newProjectProtectionBypassResource() resource.Resource {
+	return &projectProtectionBypassResource{}
+}
+
+type projectProtectionBypassResource struct {
+	client *client.Client
+}
+
+func (r *projectProtectionBypassResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_protection_bypass"
+}
+
+func (r *projectProtectionBypassResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema returns the schema information for a project protection bypass resource.
+func (r *projectProtectionBypassResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Protection Bypass for Automation secret for a Project.
+
+A Protection Bypass for Automation secret allows automation services, such as end-to-end tests, to bypass Deployment Protection when sent in the ` + "`x-vercel-protection-bypass`" + ` header.
+
+A Project can have many secrets, so each automation service can be given its own secret and have it revoked independently.
+
+To rotate a secret without downtime, change ` + "`triggers`" + ` and set ` + "`create_before_destroy`" + ` in the resource's ` + "`lifecycle`" + ` block. The new secret is then created before the old secret is revoked.
+
+~> This resource should not be used alongside the ` + "`protection_bypass_for_automation`" + ` field of the ` + "`vercel_project`" + ` resource.
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description:   "The ID of the Project.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team the Project exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseNonNullStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				Description:   "An identifier for the secret, derived from the secret so that it can be shown without revealing the secret.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"secret": schema.StringAttribute{
+				Description:   "The secret used to bypass Deployment Protection. If not set, a secret is generated.",
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9]{32}$`),
+						"The secret must be 32 alphanumeric characters.",
+					),
+				},
+			},
+			"note": schema.StringAttribute{
+				Description: "A note describing what the secret is used for, such as the name of the automation service that uses it.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description:   "Arbitrary values that, when changed, cause a new secret to be generated and the old secret to be revoked.",
+				Optional:      true,
+				ElementType:   types.StringType,
+				PlanModifiers: []planmodifier.Map{mapplanmodifier.RequiresReplace()},
+			},
+		},
+	}
+}
+
+// ProjectProtectionBypass reflects the state terraform stores internally for a project protection bypass.
+type ProjectProtectionBypass struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	ID        types.String `tfsdk:"id"`
+	Secret    types.String `tfsdk:"secret"`
+	Note      types.String `tfsdk:"note"`
+	Triggers  types.Map    `tfsdk:"triggers"`
+}
+
+// protectionBypassID derives a stable identifier from a secret, without revealing the secret.
+func protectionBypassID(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+func responseToProjectProtectionBypass(out client.ProtectionBypassSecret, triggers types.Map) ProjectProtectionBypass {
+	note := types.StringNull()
+	if out.Note != "" {
+		note = types.StringValue(out.Note)
+	}
+	return ProjectProtectionBypass{
+		ProjectID: types.StringValue(out.ProjectID),
+		TeamID:    toTeamID(out.TeamID),
+		ID:        types.StringValue(protectionBypassID(out.Secret)),
+		Secret:    types.StringValue(out.Secret),
+		Note:      note,
+		Triggers:  triggers,
+	}
+}
+
+// Create will create a protection bypass secret within Vercel.
+// This is called automatically by the provider when a new resource should be created.
+func (r *projectProtectionBypassResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectProtectionBypass
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.CreateProtectionBypass(ctx, client.CreateProtectionBypassRequest{
+		TeamID:    plan.TeamID.ValueString(),
+		ProjectID: plan.ProjectID.ValueString(),
+		Secret:    plan.Secret.ValueString(),
+		Note:      plan.Note.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Protection Bypass",
+			"Could not create Protection Bypass, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result := responseToProjectProtectionBypass(out, plan.Triggers)
+	tflog.Info(ctx, "created protection bypass", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"id":         result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read will read the protection bypass secret by requesting it from the Vercel API, and will update terraform
+// with this information.
+func (r *projectProtectionBypassResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectProtectionBypass
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetProtectionBypass(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString(), state.Secret.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Protection Bypass",
+			fmt.Sprintf("Could not get Protection Bypass %s %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.ProjectID.ValueString(),
+				state.ID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result := responseToProjectProtectionBypass(out, state.Triggers)
+	tflog.Info(ctx, "read protection bypass", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"id":         result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the note of a protection bypass secret.
+func (r *projectProtectionBypassResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ProjectProtectionBypass
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.UpdateProtectionBypass(ctx, client.UpdateProtectionBypassRequest{
+		TeamID:    plan.TeamID.ValueString(),
+		ProjectID: plan.ProjectID.ValueString(),
+		Secret:    plan.Secret.ValueString(),
+		Note:      plan.Note.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Protection Bypass",
+			"Could not update Protection Bypass, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result := responseToProjectProtectionBypass(out, plan.Triggers)
+	tflog.Info(ctx, "updated protection bypass", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"id":         result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete revokes a protection bypass secret.
+func (r *projectProtectionBypassResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectProtectionBypass
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteProtectionBypass(ctx, client.DeleteProtectionBypassRequest{
+		TeamID:    state.TeamID.ValueString(),
+		ProjectID: state.ProjectID.ValueString(),
+		Secret:    state.Secret.ValueString(),
+	})
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Protection Bypass",
+			fmt.Sprintf(
+				"Could not delete Protection Bypass %s %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.ProjectID.ValueString(),
+				state.ID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "deleted protection bypass", map[string]any{
+		"team_id":    state.TeamID.ValueString(),
+		"project_id": state.ProjectID.ValueString(),
+		"id":         state.ID.ValueString(),
+	})
+}
+
+// ImportState takes an identifier and reads the protection bypass secret from the Vercel API.
+// The results are then stored in terraform state.
+func (r *projectProtectionBypassResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, projectID, secret, ok := splitInto2Or3(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing Protection Bypass",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/project_id/secret\" or \"project_id/secret\"", req.ID),
+		)
+		return
+	}
+
+	out, err := r.client.GetProtectionBypass(ctx, projectID, teamID, secret)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing Protection Bypass",
+			fmt.Sprintf("Could not get Protection Bypass %s %s, unexpected error: %s",
+				teamID,
+				projectID,
+				err,
+			),
+		)
+		return
+	}
+
+	result := responseToProjectProtectionBypass(out, types.MapNull(types.StringType))
+	tflog.Info(ctx, "imported protection bypass", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"id":         result.ID.ValueString(),
+	})
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
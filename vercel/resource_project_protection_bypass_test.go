@@ -0,0 +1,114 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
+)
+
+func testCheckProjectProtectionBypassExists(testClient *client.Client, teamID, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		_, err := testClient.GetProtectionBypass(context.TODO(), rs.Primary.Attributes["project_id"], teamID, rs.Primary.Attributes["secret"])
+		return err
+	}
+}
+
+func TestAcc_ProjectProtectionBypassResource(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(`
+resource "vercel_project_protection_bypass" "invalid" {
+  project_id = "prj_123"
+  secret     = "1234567891234567891234567891234-"
+}
+`),
+				ExpectError: regexp.MustCompile(`The\s+secret\s+must\s+be\s+32\s+alphanumeric\s+characters`),
+			},
+			{
+				Config: cfg(testAccProjectProtectionBypassConfig(projectSuffix, "qa tool", "1")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckProjectProtectionBypassExists(testClient(t), testTeam(t), "vercel_project_protection_bypass.generated"),
+					resource.TestCheckResourceAttr("vercel_project_protection_bypass.generated", "note", "qa tool"),
+					resource.TestCheckResourceAttrSet("vercel_project_protection_bypass.generated", "secret"),
+					testCheckProjectProtectionBypassExists(testClient(t), testTeam(t), "vercel_project_protection_bypass.custom"),
+					resource.TestCheckResourceAttr("vercel_project_protection_bypass.custom", "secret", "12345678912345678912345678912345"),
+					resource.TestCheckNoResourceAttr("vercel_project_protection_bypass.custom", "note"),
+					resource.TestCheckNoResourceAttr("vercel_project.test", "protection_bypass_for_automation"),
+				),
+			},
+			{
+				ResourceName:            "vercel_project_protection_bypass.custom",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["vercel_project_protection_bypass.custom"]
+					if !ok {
+						return "", fmt.Errorf("resource not found")
+					}
+					return fmt.Sprintf("%s/%s/%s", testTeam(t), rs.Primary.Attributes["project_id"], rs.Primary.Attributes["secret"]), nil
+				},
+			},
+			{
+				// Changing the note updates the existing secret.
+				Config: cfg(testAccProjectProtectionBypassConfig(projectSuffix, "end-to-end tests", "1")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckProjectProtectionBypassExists(testClient(t), testTeam(t), "vercel_project_protection_bypass.generated"),
+					resource.TestCheckResourceAttr("vercel_project_protection_bypass.generated", "note", "end-to-end tests"),
+				),
+			},
+			{
+				// Changing the triggers rotates the secret.
+				Config: cfg(testAccProjectProtectionBypassConfig(projectSuffix, "end-to-end tests", "2")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckProjectProtectionBypassExists(testClient(t), testTeam(t), "vercel_project_protection_bypass.generated"),
+					testCheckProjectProtectionBypassExists(testClient(t), testTeam(t), "vercel_project_protection_bypass.custom"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectProtectionBypassConfig(projectSuffix, note, rotation string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-protection-bypass-%[1]s"
+}
+
+resource "vercel_project_protection_bypass" "generated" {
+  project_id = vercel_project.test.id
+  note       = "%[2]s"
+  triggers = {
+    rotation = "%[3]s"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "vercel_project_protection_bypass" "custom" {
+  project_id = vercel_project.test.id
+  secret     = "12345678912345678912345678912345"
+}
+`, projectSuffix, note, rotation)
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
-	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -19,9 +18,10 @@ import (
 )
 
 var (
-	_ resource.Resource                = &projectRollingReleaseResource{}
-	_ resource.ResourceWithConfigure   = &projectRollingReleaseResource{}
-	_ resource.ResourceWithImportState = &projectRollingReleaseResource{}
+	_ resource.Resource                     = &projectRollingReleaseResource{}
+	_ resource.ResourceWithConfigure        = &projectRollingReleaseResource{}
+	_ resource.ResourceWithImportState      = &projectRollingReleaseResource{}
+	_ resource.ResourceWithConfigValidators = &projectRollingReleaseResource{}
 )
 
 func newProjectRollingReleaseResource() resource.Resource {
@@ -54,115 +54,105 @@ func (r *projectRollingReleaseResource) Configure(ctx context.Context, req resou
 	r.client = client
 }
 
-// durationValidator validates that duration is only present when advancement_type is "automatic"
-type durationValidator struct{}
+var _ resource.ConfigValidator = &rollingReleaseStagesValidator{}
 
-func (v durationValidator) Description(ctx context.Context) string {
-	return "Duration is required when advancement_type is 'automatic'"
-}
+// rollingReleaseStagesValidator validates the stages of a rolling release as a whole, as the rules for each
+// stage depend on the other stages and on the advancement type.
+type rollingReleaseStagesValidator struct{}
 
-func (v durationValidator) MarkdownDescription(ctx context.Context) string {
-	return "Duration is required when advancement_type is 'automatic'"
+func (v *rollingReleaseStagesValidator) Description(ctx context.Context) string {
+	return "Validates that the stages have strictly increasing target percentages ending at 100, and a duration only for automatic advancement."
 }
 
-func (v durationValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
-	// Get the parent advancement_type value
-	parentPath := req.Path.ParentPath()
-	advancementTypePath := parentPath.ParentPath().AtName("advancement_type")
+func (v *rollingReleaseStagesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
 
-	var advancementType types.String
-	diags := req.Config.GetAttribute(ctx, advancementTypePath, &advancementType)
-	if diags.HasError() {
+func (v *rollingReleaseStagesValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config RollingReleaseInfo
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-
-	// Check if duration is set
-	durationAttr, exists := req.ConfigValue.Attributes()["duration"]
-	if !exists {
-		return
-	}
-	// Check if target_percentage is set
-	targetPercentageAttr, exists := req.ConfigValue.Attributes()["target_percentage"]
-	if !exists {
-		resp.Diagnostics.AddAttributeError(
-			req.Path.AtName("target_percentage"),
-			"Invalid target_percentage configuration",
-			"target_percentage must be set",
-		)
-	}
-
-	duration := durationAttr.(types.Int64)
-	targetPercentage := targetPercentageAttr.(types.Int64)
-	if duration.IsNull() || duration.IsUnknown() {
-		if advancementType.ValueString() == "automatic" && targetPercentage.ValueInt64() != 100 {
-			resp.Diagnostics.AddAttributeError(
-				req.Path.AtName("duration"),
-				"Invalid duration configuration",
-				"duration must be set when advancement_type is 'automatic'",
-			)
-		}
-		return
-	}
-
-	// If duration is set but advancement_type is not "automatic", add an error
-	if advancementType.ValueString() != "automatic" {
-		resp.Diagnostics.AddAttributeError(
-			req.Path.AtName("duration"),
-			"Invalid duration configuration",
-			"duration can only be set when advancement_type is 'automatic'",
-		)
-	}
-}
-
-// terminalStageValidator validates that the last stage has target_percentage = 100
-type terminalStageValidator struct{}
-
-func (v terminalStageValidator) Description(ctx context.Context) string {
-	return "The last stage must have target_percentage = 100"
-}
-
-func (v terminalStageValidator) MarkdownDescription(ctx context.Context) string {
-	return "The last stage must have target_percentage = 100"
-}
-
-func (v terminalStageValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
-	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+	if config.Stages.IsNull() || config.Stages.IsUnknown() {
 		return
 	}
 
 	var stages []RollingReleaseStage
-	diags := req.ConfigValue.ElementsAs(ctx, &stages, false)
+	diags = config.Stages.ElementsAs(ctx, &stages, false)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if len(stages) == 0 {
-		return
-	}
-
-	// Check that the last stage has target_percentage = 100
-	lastStage := stages[len(stages)-1]
-	if lastStage.TargetPercentage.ValueInt64() != 100 {
+	if len(stages) < 2 || len(stages) > 10 {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("stages").AtListIndex(len(stages)-1).AtName("target_percentage"),
-			"Invalid terminal stage",
-			"The last stage must have target_percentage = 100",
+			path.Root("stages"),
+			"Invalid number of stages",
+			fmt.Sprintf("A rolling release must have between 2 and 10 stages, including the final stage with target_percentage = 100, but %d were configured.", len(stages)),
 		)
+		return
 	}
 
-	// Check that no other stage has target_percentage = 100
-	for i, stage := range stages[:len(stages)-1] {
-		if stage.TargetPercentage.ValueInt64() == 100 {
+	automatic := config.AdvancementType.ValueString() == "automatic"
+	previous := int64(-1)
+	for i, stage := range stages {
+		stagePath := path.Root("stages").AtListIndex(i)
+		final := i == len(stages)-1
+
+		if !stage.TargetPercentage.IsUnknown() {
+			percentage := stage.TargetPercentage.ValueInt64()
+			switch {
+			case final && percentage != 100:
+				resp.Diagnostics.AddAttributeError(
+					stagePath.AtName("target_percentage"),
+					"Invalid terminal stage",
+					fmt.Sprintf("The last stage must have target_percentage = 100, but stage %d has target_percentage = %d.", i, percentage),
+				)
+			case !final && percentage >= 100:
+				resp.Diagnostics.AddAttributeError(
+					stagePath.AtName("target_percentage"),
+					"Invalid stage percentage",
+					fmt.Sprintf("Only the last stage can have target_percentage = 100, but stage %d has target_percentage = %d.", i, percentage),
+				)
+			case percentage <= previous:
+				resp.Diagnostics.AddAttributeError(
+					stagePath.AtName("target_percentage"),
+					"Invalid stage percentage",
+					fmt.Sprintf("The target_percentage of each stage must be greater than the previous stage, but stage %d has target_percentage = %d after %d.", i, percentage, previous),
+				)
+			}
+			previous = percentage
+		}
+
+		// The advancement type may be unknown, in which case the durations can't be validated.
+		if config.AdvancementType.IsUnknown() || stage.Duration.IsUnknown() {
+			continue
+		}
+		if automatic && !final && stage.Duration.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				stagePath.AtName("duration"),
+				"Invalid duration configuration",
+				fmt.Sprintf("duration must be set for stage %d, as advancement_type is 'automatic'.", i),
+			)
+		}
+		if !automatic && !stage.Duration.IsNull() {
 			resp.Diagnostics.AddAttributeError(
-				path.Root("stages").AtListIndex(i).AtName("target_percentage"),
-				"Invalid stage percentage",
-				"Only the last stage can have target_percentage = 100",
+				stagePath.AtName("duration"),
+				"Invalid duration configuration",
+				fmt.Sprintf("duration can only be set when advancement_type is 'automatic', but is set for stage %d.", i),
 			)
 		}
 	}
 }
 
+func (r *projectRollingReleaseResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		&rollingReleaseStagesValidator{},
+	}
+}
+
 // Schema returns the schema information for a project rolling release resource.
 func (r *projectRollingReleaseResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -185,13 +175,8 @@ func (r *projectRollingReleaseResource) Schema(ctx context.Context, _ resource.S
 				},
 			},
 			"stages": schema.ListNestedAttribute{
-				MarkdownDescription: "The stages for the rolling release configuration. The last stage must have target_percentage = 100.",
+				MarkdownDescription: "The stages for the rolling release configuration. Between 2 and 10 stages are supported, with strictly increasing target percentages. The last stage must have target_percentage = 100.",
 				Required:            true,
-				Validators: []validator.List{
-					listvalidator.SizeAtLeast(1),
-					listvalidator.SizeAtMost(10),
-					terminalStageValidator{},
-				},
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"target_percentage": schema.Int64Attribute{
@@ -209,9 +194,6 @@ func (r *projectRollingReleaseResource) Schema(ctx context.Context, _ resource.S
 							},
 						},
 					},
-					Validators: []validator.Object{
-						durationValidator{},
-					},
 				},
 			},
 		},
@@ -3,6 +3,7 @@ package vercel_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -249,3 +250,59 @@ resource "vercel_project_rolling_release" "example" {
 }
 `, nameSuffix)
 }
+
+func TestAcc_ProjectRollingRelease_InvalidStages(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccProjectRollingReleaseInvalidStagesConfig("manual-approval", `
+		{ target_percentage = 50 },
+		{ target_percentage = 20 },
+		{ target_percentage = 100 },`)),
+				ExpectError: regexp.MustCompile(`(?s)greater\s+than\s+the\s+previous\s+stage.*stage\s+1\s+has`),
+			},
+			{
+				Config: cfg(testAccProjectRollingReleaseInvalidStagesConfig("manual-approval", `
+		{ target_percentage = 20 },
+		{ target_percentage = 50 },`)),
+				ExpectError: regexp.MustCompile(`(?s)last\s+stage\s+must\s+have.*stage\s+1\s+has`),
+			},
+			{
+				Config: cfg(testAccProjectRollingReleaseInvalidStagesConfig("automatic", `
+		{
+			target_percentage = 20
+			duration          = 10
+		},
+		{ target_percentage = 50 },
+		{ target_percentage = 100 },`)),
+				ExpectError: regexp.MustCompile(`duration\s+must\s+be\s+set\s+for\s+stage\s+1`),
+			},
+			{
+				Config: cfg(testAccProjectRollingReleaseInvalidStagesConfig("manual-approval", `
+		{
+			target_percentage = 20
+			duration          = 10
+		},
+		{ target_percentage = 100 },`)),
+				ExpectError: regexp.MustCompile(`(?s)can\s+only\s+be\s+set.*for\s+stage\s+0`),
+			},
+			{
+				Config: cfg(testAccProjectRollingReleaseInvalidStagesConfig("manual-approval", `
+		{ target_percentage = 100 },`)),
+				ExpectError: regexp.MustCompile(`between\s+2\s+and\s+10\s+stages`),
+			},
+		},
+	})
+}
+
+func testAccProjectRollingReleaseInvalidStagesConfig(advancementType, stages string) string {
+	return fmt.Sprintf(`
+resource "vercel_project_rolling_release" "example" {
+	project_id = "prj_invalid"
+	advancement_type = "%s"
+	stages = [%s
+	]
+}
+`, advancementType, stages)
+}
@@ -559,6 +559,13 @@ func TestAcc_ProjectWithAutomationBypass(t *testing.T) {
 					resource.TestCheckResourceAttr("vercel_project.enabled_custom_secret_to_disabled", "protection_bypass_for_automation", "false"),
 				),
 			},
+			{
+				// Importing a project with Protection Bypass for Automation enabled should keep the secret.
+				ResourceName:      "vercel_project.disabled_to_enabled_custom_secret",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getProjectImportID("vercel_project.disabled_to_enabled_custom_secret"),
+			},
 		},
 	})
 }
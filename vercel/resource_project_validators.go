@@ -2,8 +2,12 @@ package vercel
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/vercel/terraform-provider-vercel/v3/client"
 )
 
 var _ resource.ConfigValidator = &fluidComputeBasicCPUValidator{}
@@ -60,3 +64,22 @@ func (v *fluidComputeBasicCPUValidator) ValidateResource(ctx context.Context, re
 		"Fluid compute is only supported with the standard or performance CPU types.",
 	)
 }
+
+// validateFunctionsForResourceConfig validates per-function overrides against the project's resource configuration.
+// With fluid compute, memory is determined by the project's CPU type, so it cannot be set per function.
+func validateFunctionsForResourceConfig(functions map[string]DeploymentFunction, resourceConfig *client.ResourceConfigResponse) (diags diag.Diagnostics) {
+	if resourceConfig == nil || !resourceConfig.Fluid {
+		return diags
+	}
+	for pattern, f := range functions {
+		if f.Memory.IsNull() || f.Memory.IsUnknown() {
+			continue
+		}
+		diags.AddAttributeError(
+			path.Root("functions").AtMapKey(pattern).AtName("memory"),
+			"Error validating function configuration",
+			fmt.Sprintf("The project uses fluid compute, so memory cannot be set for %q. Set `resource_config.function_default_cpu_type` on the project instead.", pattern),
+		)
+	}
+	return diags
+}
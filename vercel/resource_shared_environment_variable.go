@@ -3,7 +3,9 @@ package vercel
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/vercel/terraform-provider-vercel/v3/client"
 )
@@ -47,6 +50,11 @@ func (r *sharedEnvironmentVariableResource) ModifyPlan(ctx context.Context, req
 		return
 	}
 
+	r.modifyPlanForProjectSelector(ctx, req, config, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if config.ID.ValueString() != "" {
 		// The resource already exists, so this is okay.
 		return
@@ -79,6 +87,62 @@ func (r *sharedEnvironmentVariableResource) ModifyPlan(ctx context.Context, req
 	)
 }
 
+// modifyPlanForProjectSelector resolves the project_selector into the IDs of the matching projects, so
+// that the projects the environment variable will be linked to are shown in the plan.
+func (r *sharedEnvironmentVariableResource) modifyPlanForProjectSelector(ctx context.Context, req resource.ModifyPlanRequest, config SharedEnvironmentVariable, resp *resource.ModifyPlanResponse) {
+	if config.ProjectSelector.IsNull() || config.ProjectSelector.IsUnknown() {
+		return
+	}
+	teamID := config.TeamID
+	if teamID.IsUnknown() {
+		// The team_id is computed until the resource is created, so fall back to the configured
+		// value, which is null when the provider's default team should be used.
+		diags := req.Config.GetAttribute(ctx, path.Root("team_id"), &teamID)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() || teamID.IsUnknown() {
+			return
+		}
+	}
+	var selector ProjectSelector
+	diags := config.ProjectSelector.As(ctx, &selector, basetypes.ObjectAsOptions{
+		UnhandledNullAsEmpty:    false,
+		UnhandledUnknownAsEmpty: false,
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if selector.Name.IsUnknown() || selector.Framework.IsUnknown() || selector.GitRepositoryPrefix.IsUnknown() {
+		return
+	}
+
+	projects, err := r.client.ListProjects(ctx, teamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error resolving project_selector",
+			"Could not list projects, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	projectIDs := []attr.Value{}
+	for _, p := range projects {
+		if selector.matches(p) {
+			projectIDs = append(projectIDs, types.StringValue(p.ID))
+		}
+	}
+	if len(projectIDs) == 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("project_selector"),
+			"No projects match project_selector",
+			"The project_selector does not match any projects, so the shared environment variable will not be linked to any projects.",
+		)
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("project_ids"), types.SetValueMust(types.StringType, projectIDs))
+	resp.Diagnostics.Append(diags...)
+}
+
 func (r *sharedEnvironmentVariableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_shared_environment_variable"
 }
@@ -127,14 +191,66 @@ For more detailed information, please see the [Vercel documentation](https://ver
 				Description:   "The name of the Environment Variable.",
 			},
 			"value": schema.StringAttribute{
-				Required:    true,
-				Description: "The value of the Environment Variable.",
+				Optional:    true,
+				Description: "The value of the Environment Variable. Exactly one of `value` or `value_wo` must be set.",
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("value"),
+						path.MatchRoot("value_wo"),
+					),
+				},
+			},
+			"value_wo": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
 				Sensitive:   true,
+				Description: "The value of the Environment Variable, as a write-only attribute that is never stored in the plan or state. Terraform cannot detect changes to a write-only value, so `value_wo_version` must be changed for a new value to be applied. Requires Terraform 1.11 or later. Exactly one of `value` or `value_wo` must be set.",
+			},
+			"value_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The version of `value_wo`. Change this to update the Environment Variable with the current `value_wo`.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("value_wo")),
+				},
 			},
 			"project_ids": schema.SetAttribute{
-				Required:    true,
-				Description: "The ID of the Vercel project.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The IDs of the Vercel projects that the Environment Variable should be linked to. Exactly one of `project_ids` or `project_selector` must be set.",
 				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ExactlyOneOf(path.MatchRoot("project_selector")),
+				},
+			},
+			"project_selector": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Links the Environment Variable to every project in the team that matches all of the specified criteria. The matching projects are resolved each time a plan is created, and shown in the plan as `project_ids`, so newly created projects are linked by the next apply. Exactly one of `project_ids` or `project_selector` must be set.",
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Optional:    true,
+						Description: "A glob pattern, such as `web-*`, that the project name must match.",
+						Validators: []validator.String{
+							validateGlobPattern(),
+							stringvalidator.AtLeastOneOf(
+								path.MatchRelative().AtParent().AtName("name"),
+								path.MatchRelative().AtParent().AtName("framework"),
+								path.MatchRelative().AtParent().AtName("git_repository_prefix"),
+							),
+						},
+					},
+					"framework": schema.StringAttribute{
+						Optional:    true,
+						Description: "The framework that the project must use, such as `nextjs`.",
+						Validators: []validator.String{
+							validateFramework(),
+						},
+					},
+					"git_repository_prefix": schema.StringAttribute{
+						Optional:    true,
+						Description: "A prefix that the git repository connected to the project must start with, such as `my-org/` to match every repository owned by `my-org`. The repository is in the form `owner/repo`.",
+					},
+				},
 			},
 			"team_id": schema.StringAttribute{
 				Optional:      true,
@@ -249,14 +365,25 @@ type SharedEnvironmentVariable struct {
 	Target                       types.Set    `tfsdk:"target"`
 	Key                          types.String `tfsdk:"key"`
 	Value                        types.String `tfsdk:"value"`
+	ValueWO                      types.String `tfsdk:"value_wo"`
+	ValueWOVersion               types.Int64  `tfsdk:"value_wo_version"`
 	TeamID                       types.String `tfsdk:"team_id"`
 	ProjectIDs                   types.Set    `tfsdk:"project_ids"`
+	ProjectSelector              types.Object `tfsdk:"project_selector"`
 	ID                           types.String `tfsdk:"id"`
 	Sensitive                    types.Bool   `tfsdk:"sensitive"`
 	Comment                      types.String `tfsdk:"comment"`
 	ApplyToAllCustomEnvironments types.Bool   `tfsdk:"apply_to_all_custom_environments"`
 }
 
+// requestValue returns the value to send to the API, which is either the value or the write-only value.
+func (e *SharedEnvironmentVariable) requestValue() string {
+	if e.Value.IsNull() {
+		return e.ValueWO.ValueString()
+	}
+	return e.Value.ValueString()
+}
+
 func (e *SharedEnvironmentVariable) toCreateSharedEnvironmentVariableRequest(ctx context.Context, diags diag.Diagnostics) (req client.CreateSharedEnvironmentVariableRequest, ok bool) {
 	var target []string
 	if e.Target.IsNull() || e.Target.IsUnknown() {
@@ -293,7 +420,7 @@ func (e *SharedEnvironmentVariable) toCreateSharedEnvironmentVariableRequest(ctx
 			EnvironmentVariables: []client.SharedEnvVarRequest{
 				{
 					Key:     e.Key.ValueString(),
-					Value:   e.Value.ValueString(),
+					Value:   e.requestValue(),
 					Comment: e.Comment.ValueString(),
 				},
 			},
@@ -329,7 +456,7 @@ func (e *SharedEnvironmentVariable) toUpdateSharedEnvironmentVariableRequest(ctx
 	}
 	return client.UpdateSharedEnvironmentVariableRequest{
 		ApplyToAllCustomEnvironments: e.ApplyToAllCustomEnvironments.ValueBool(),
-		Value:                        e.Value.ValueString(),
+		Value:                        e.requestValue(),
 		Target:                       target,
 		Type:                         envVariableType,
 		TeamID:                       e.TeamID.ValueString(),
@@ -364,6 +491,7 @@ func convertResponseToSharedEnvironmentVariable(response client.SharedEnvironmen
 		Key:                          types.StringValue(response.Key),
 		Value:                        value,
 		ProjectIDs:                   types.SetValueMust(types.StringType, projectIDs),
+		ProjectSelector:              types.ObjectNull(projectSelectorAttrType.AttrTypes),
 		TeamID:                       toTeamID(response.TeamID),
 		ID:                           types.StringValue(response.ID),
 		Sensitive:                    types.BoolValue(response.Type == "sensitive"),
@@ -371,6 +499,46 @@ func convertResponseToSharedEnvironmentVariable(response client.SharedEnvironmen
 	}
 }
 
+// ProjectSelector reflects the criteria used to select the projects a shared environment variable is linked to.
+type ProjectSelector struct {
+	Name                types.String `tfsdk:"name"`
+	Framework           types.String `tfsdk:"framework"`
+	GitRepositoryPrefix types.String `tfsdk:"git_repository_prefix"`
+}
+
+var projectSelectorAttrType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":                  types.StringType,
+		"framework":             types.StringType,
+		"git_repository_prefix": types.StringType,
+	},
+}
+
+// matches reports whether a project meets all of the criteria of the selector.
+func (s ProjectSelector) matches(project client.ProjectResponse) bool {
+	if !s.Name.IsNull() && !matchesAnyGlob([]string{s.Name.ValueString()}, project.Name) {
+		return false
+	}
+	if !s.Framework.IsNull() && (project.Framework == nil || *project.Framework != s.Framework.ValueString()) {
+		return false
+	}
+	if !s.GitRepositoryPrefix.IsNull() {
+		repo := project.Repository()
+		if repo == nil || !strings.HasPrefix(repo.Repo, s.GitRepositoryPrefix.ValueString()) {
+			return false
+		}
+	}
+	return true
+}
+
+// withWriteOnlyValue keeps the value out of state if the environment variable uses a write-only value.
+func (e *SharedEnvironmentVariable) withWriteOnlyValue(prior SharedEnvironmentVariable) {
+	e.ValueWOVersion = prior.ValueWOVersion
+	if prior.Value.IsNull() {
+		e.Value = types.StringNull()
+	}
+}
+
 // Create will create a new shared environment variable.
 // This is called automatically by the provider when a new resource should be created.
 func (r *sharedEnvironmentVariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -381,6 +549,12 @@ func (r *sharedEnvironmentVariableResource) Create(ctx context.Context, req reso
 		return
 	}
 
+	diags = req.Config.GetAttribute(ctx, path.Root("value_wo"), &plan.ValueWO)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	request, ok := plan.toCreateSharedEnvironmentVariableRequest(ctx, resp.Diagnostics)
 	if !ok {
 		return
@@ -395,6 +569,8 @@ func (r *sharedEnvironmentVariableResource) Create(ctx context.Context, req reso
 	}
 
 	result := convertResponseToSharedEnvironmentVariable(response, plan.Value)
+	result.withWriteOnlyValue(plan)
+	result.ProjectSelector = plan.ProjectSelector
 
 	tflog.Info(ctx, "created shared environment variable", map[string]any{
 		"id":      result.ID.ValueString(),
@@ -436,6 +612,8 @@ func (r *sharedEnvironmentVariableResource) Read(ctx context.Context, req resour
 	}
 
 	result := convertResponseToSharedEnvironmentVariable(out, state.Value)
+	result.withWriteOnlyValue(state)
+	result.ProjectSelector = state.ProjectSelector
 	tflog.Info(ctx, "read shared environment variable", map[string]any{
 		"id":      result.ID.ValueString(),
 		"team_id": result.TeamID.ValueString(),
@@ -456,6 +634,13 @@ func (r *sharedEnvironmentVariableResource) Update(ctx context.Context, req reso
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	diags = req.Config.GetAttribute(ctx, path.Root("value_wo"), &plan.ValueWO)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	request, ok := plan.toUpdateSharedEnvironmentVariableRequest(ctx, resp.Diagnostics)
 	if !ok {
 		return
@@ -470,6 +655,8 @@ func (r *sharedEnvironmentVariableResource) Update(ctx context.Context, req reso
 	}
 
 	result := convertResponseToSharedEnvironmentVariable(response, plan.Value)
+	result.withWriteOnlyValue(plan)
+	result.ProjectSelector = plan.ProjectSelector
 
 	tflog.Info(ctx, "updated shared environment variable", map[string]any{
 		"id":      result.ID.ValueString(),
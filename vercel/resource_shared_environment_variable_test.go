@@ -323,3 +323,57 @@ resource "vercel_shared_environment_variable" "computed_custom" {
 }
 `, projectName)
 }
+
+func TestAcc_SharedEnvironmentVariables_ProjectSelector(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.matching", testTeam(t)),
+			testAccProjectDestroy(testClient(t), "vercel_project.other", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				// The selector is resolved at plan time, so the projects must exist first.
+				Config: cfg(testAccSharedEnvironmentVariablesProjectSelectorConfig(nameSuffix, false)),
+			},
+			{
+				Config: cfg(testAccSharedEnvironmentVariablesProjectSelectorConfig(nameSuffix, true)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccSharedEnvironmentVariableExists(testClient(t), "vercel_shared_environment_variable.selector", testTeam(t)),
+					resource.TestCheckResourceAttr("vercel_shared_environment_variable.selector", "project_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("vercel_shared_environment_variable.selector", "project_ids.*", "vercel_project.matching", "id"),
+					resource.TestCheckResourceAttr("vercel_shared_environment_variable.selector", "project_selector.name", fmt.Sprintf("test-acc-selector-%s-*", nameSuffix)),
+				),
+			},
+		},
+	})
+}
+
+func testAccSharedEnvironmentVariablesProjectSelectorConfig(projectName string, withVariable bool) string {
+	config := fmt.Sprintf(`
+resource "vercel_project" "matching" {
+	name      = "test-acc-selector-%[1]s-web"
+	framework = "nextjs"
+}
+
+resource "vercel_project" "other" {
+	name      = "test-acc-selector-%[1]s-api"
+	framework = "vite"
+}
+`, projectName)
+	if !withVariable {
+		return config
+	}
+	return config + fmt.Sprintf(`
+resource "vercel_shared_environment_variable" "selector" {
+	key    = "test_acc_selector_%[1]s"
+	value  = "bar"
+	target = ["production"]
+	project_selector = {
+		name      = "test-acc-selector-%[1]s-*"
+		framework = "nextjs"
+	}
+}
+`, projectName)
+}
@@ -1,6 +1,8 @@
 package vercel
 
 import (
+	"context"
+	"fmt"
 	"math"
 	"time"
 )
@@ -37,3 +39,25 @@ func (r *Retry) getSleepDuration(attempt int) time.Duration {
 
 	return sleep
 }
+
+// pollUntil calls fn every interval until it reports that it is done, it returns an error, or the timeout
+// elapses. fn is passed a context that is cancelled when the timeout elapses, so that a slow request does
+// not outlive the timeout.
+func pollUntil(ctx context.Context, timeout, interval time.Duration, fn func(ctx context.Context) (done bool, err error)) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for {
+		done, err := fn(ctx)
+		if err != nil && ctx.Err() == context.DeadlineExceeded {
+			return fmt.Errorf("timed out after %s", timeout)
+		}
+		if err != nil || done {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out after %s", timeout)
+		case <-time.After(interval):
+		}
+	}
+}
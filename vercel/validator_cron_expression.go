@@ -0,0 +1,219 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = validatorCronExpression{}
+
+func validateCronExpression() validatorCronExpression {
+	return validatorCronExpression{}
+}
+
+type validatorCronExpression struct {
+}
+
+func (v validatorCronExpression) Description(ctx context.Context) string {
+	return "Value must be a valid cron expression"
+}
+func (v validatorCronExpression) MarkdownDescription(ctx context.Context) string {
+	return "Value must be a valid cron expression"
+}
+
+func (v validatorCronExpression) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	schedule, err := parseCronExpression(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid cron expression",
+			fmt.Sprintf("Value must be a valid cron expression, but it could not be parsed: %s.", err),
+		)
+		return
+	}
+	if schedule.next(time.Now().UTC()).IsZero() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid cron expression",
+			fmt.Sprintf("The cron expression %q never runs.", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// cronSchedule is a parsed cron expression. Each field holds the values it matches.
+type cronSchedule struct {
+	minutes     map[int]bool
+	hours       map[int]bool
+	daysOfMonth map[int]bool
+	months      map[int]bool
+	daysOfWeek  map[int]bool
+	// Following standard cron behaviour, when both the day of month and the day of week are
+	// restricted, a day matches if either of them match.
+	domRestricted bool
+	dowRestricted bool
+}
+
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	// Both 0 and 7 are Sunday.
+	{name: "day of week", min: 0, max: 7},
+}
+
+// parseCronExpression parses a cron expression in the format supported by Vercel. This is the
+// standard five fields, supporting `*`, lists, ranges and steps. Names (such as `MON` or `JAN`) and
+// macros (such as `@daily`) are not supported. All times are in UTC.
+func parseCronExpression(expression string) (s cronSchedule, err error) {
+	fields := strings.Fields(expression)
+	if len(fields) != len(cronFields) {
+		return s, fmt.Errorf("expected 5 fields (minute, hour, day of month, month, day of week), got %d", len(fields))
+	}
+
+	values := make([]map[int]bool, len(fields))
+	for i, field := range fields {
+		values[i], err = parseCronField(field, cronFields[i])
+		if err != nil {
+			return s, err
+		}
+	}
+	if values[4][7] {
+		delete(values[4], 7)
+		values[4][0] = true
+	}
+	return cronSchedule{
+		minutes:       values[0],
+		hours:         values[1],
+		daysOfMonth:   values[2],
+		months:        values[3],
+		daysOfWeek:    values[4],
+		domRestricted: !strings.HasPrefix(fields[2], "*"),
+		dowRestricted: !strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+func parseCronField(value string, field cronField) (map[int]bool, error) {
+	matches := map[int]bool{}
+	for _, part := range strings.Split(value, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step < 1 {
+				return nil, fmt.Errorf("invalid step %q in %s field", stepPart, field.name)
+			}
+		}
+
+		start, end := field.min, field.max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			from, to, _ := strings.Cut(rangePart, "-")
+			var err error
+			if start, err = parseCronValue(from, field); err != nil {
+				return nil, err
+			}
+			if end, err = parseCronValue(to, field); err != nil {
+				return nil, err
+			}
+			if start > end {
+				return nil, fmt.Errorf("invalid range %q in %s field", rangePart, field.name)
+			}
+		default:
+			v, err := parseCronValue(rangePart, field)
+			if err != nil {
+				return nil, err
+			}
+			start = v
+			if !hasStep {
+				end = v
+			}
+		}
+
+		for i := start; i <= end; i += step {
+			matches[i] = true
+		}
+	}
+	return matches, nil
+}
+
+func parseCronValue(value string, field cronField) (int, error) {
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in %s field", value, field.name)
+	}
+	if v < field.min || v > field.max {
+		return 0, fmt.Errorf("value %d in %s field must be between %d and %d", v, field.name, field.min, field.max)
+	}
+	return v, nil
+}
+
+func (s cronSchedule) matchesDay(t time.Time) bool {
+	dom := s.daysOfMonth[t.Day()]
+	dow := s.daysOfWeek[int(t.Weekday())]
+	if s.domRestricted && s.dowRestricted {
+		return dom || dow
+	}
+	return dom && dow
+}
+
+// next returns the first time after t that the schedule runs, or the zero time if it never runs.
+func (s cronSchedule) next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	// Every schedule that can run does so within a leap year cycle.
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if !s.months[int(t.Month())] {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.hours[t.Hour()] {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if !s.minutes[t.Minute()] {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// nextRuns returns up to n times after t that the schedule runs.
+func (s cronSchedule) nextRuns(t time.Time, n int) []time.Time {
+	var runs []time.Time
+	for i := 0; i < n; i++ {
+		t = s.next(t)
+		if t.IsZero() {
+			break
+		}
+		runs = append(runs, t)
+	}
+	return runs
+}
+
+// runsMoreThanDaily reports whether the schedule can run more than once on the same day.
+func (s cronSchedule) runsMoreThanDaily() bool {
+	return len(s.minutes) > 1 || len(s.hours) > 1
+}
@@ -0,0 +1,112 @@
+package vercel
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseCronExpression(t *testing.T) {
+	for _, tc := range []struct {
+		Expression string
+		Error      string
+	}{
+		{Expression: "* * * * *"},
+		{Expression: "0 5 * * 1-5"},
+		{Expression: "*/15 0-23/2 1,15 1-12 0,7"},
+		{Expression: "0 5 * *", Error: "expected 5 fields"},
+		{Expression: "60 * * * *", Error: "value 60 in minute field must be between 0 and 59"},
+		{Expression: "0 24 * * *", Error: "value 24 in hour field must be between 0 and 23"},
+		{Expression: "0 0 0 * *", Error: "value 0 in day of month field must be between 1 and 31"},
+		{Expression: "0 0 * 13 *", Error: "value 13 in month field must be between 1 and 12"},
+		{Expression: "0 0 * * 8", Error: "value 8 in day of week field must be between 0 and 7"},
+		{Expression: "0 0 * * MON", Error: `invalid value "MON" in day of week field`},
+		{Expression: "*/0 * * * *", Error: `invalid step "0" in minute field`},
+		{Expression: "0 5-1 * * *", Error: `invalid range "5-1" in hour field`},
+		{Expression: "@daily", Error: "expected 5 fields"},
+	} {
+		t.Run(tc.Expression, func(t *testing.T) {
+			_, err := parseCronExpression(tc.Expression)
+			if tc.Error == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.Error) {
+				t.Fatalf("expected error %q, but got %v", tc.Error, err)
+			}
+		})
+	}
+}
+
+func TestCronScheduleNextRuns(t *testing.T) {
+	// A Wednesday.
+	start := time.Date(2025, time.January, 1, 10, 30, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		Name       string
+		Expression string
+		Want       []string
+	}{
+		{
+			Name:       "daily",
+			Expression: "0 5 * * *",
+			Want:       []string{"2025-01-02T05:00:00Z", "2025-01-03T05:00:00Z", "2025-01-04T05:00:00Z"},
+		},
+		{
+			Name:       "every 15 minutes",
+			Expression: "*/15 * * * *",
+			Want:       []string{"2025-01-01T10:45:00Z", "2025-01-01T11:00:00Z", "2025-01-01T11:15:00Z"},
+		},
+		{
+			Name:       "7 is Sunday",
+			Expression: "0 0 * * 7",
+			Want:       []string{"2025-01-05T00:00:00Z", "2025-01-12T00:00:00Z", "2025-01-19T00:00:00Z"},
+		},
+		{
+			Name:       "day of month or day of week",
+			Expression: "0 0 10 * 5",
+			Want:       []string{"2025-01-03T00:00:00Z", "2025-01-10T00:00:00Z", "2025-01-17T00:00:00Z"},
+		},
+		{
+			Name:       "leap day",
+			Expression: "0 0 29 2 *",
+			Want:       []string{"2028-02-29T00:00:00Z", "2032-02-29T00:00:00Z", "2036-02-29T00:00:00Z"},
+		},
+		{
+			Name:       "never runs",
+			Expression: "0 0 30 2 *",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			s, err := parseCronExpression(tc.Expression)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			var got []string
+			for _, run := range s.nextRuns(start, 3) {
+				got = append(got, run.Format(time.RFC3339))
+			}
+			if strings.Join(got, ",") != strings.Join(tc.Want, ",") {
+				t.Errorf("expected %v, but got %v", tc.Want, got)
+			}
+		})
+	}
+}
+
+func TestCronScheduleRunsMoreThanDaily(t *testing.T) {
+	for expression, want := range map[string]bool{
+		"0 5 * * *":    false,
+		"0 5 * * 1-5":  false,
+		"0 5,17 * * *": true,
+		"*/30 5 * * *": true,
+	} {
+		s, err := parseCronExpression(expression)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got := s.runsMoreThanDaily(); got != want {
+			t.Errorf("expected runsMoreThanDaily of %q to be %t, but got %t", expression, want, got)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = validatorDuration{}
+
+func validateDuration() validatorDuration {
+	return validatorDuration{}
+}
+
+type validatorDuration struct {
+}
+
+func (v validatorDuration) Description(ctx context.Context) string {
+	return "Value must be a positive duration, such as 30s, 10m or 1h"
+}
+func (v validatorDuration) MarkdownDescription(ctx context.Context) string {
+	return "Value must be a positive duration, such as `30s`, `10m` or `1h`"
+}
+
+func (v validatorDuration) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid value provided",
+			fmt.Sprintf("Value must be a duration such as 30s, 10m or 1h, but it could not be parsed: %s.", err),
+		)
+		return
+	}
+	if d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid value provided",
+			"Value must be a positive duration.",
+		)
+	}
+}
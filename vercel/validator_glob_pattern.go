@@ -0,0 +1,51 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = validatorGlobPattern{}
+
+func validateGlobPattern() validatorGlobPattern {
+	return validatorGlobPattern{}
+}
+
+type validatorGlobPattern struct {
+}
+
+func (v validatorGlobPattern) Description(ctx context.Context) string {
+	return "Value must be a valid glob pattern"
+}
+func (v validatorGlobPattern) MarkdownDescription(ctx context.Context) string {
+	return "Value must be a valid glob pattern"
+}
+
+func (v validatorGlobPattern) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	if _, err := path.Match(req.ConfigValue.ValueString(), ""); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid value provided",
+			fmt.Sprintf("Value must be a valid glob pattern, but it could not be parsed: %s.", err),
+		)
+		return
+	}
+}
+
+// matchesAnyGlob reports whether a value matches any of a set of glob patterns. The patterns
+// are expected to have been validated with validateGlobPattern, so invalid patterns never match.
+func matchesAnyGlob(patterns []string, value string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, value); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// writeOnlyEnvironmentValues reads a write-only map of environment variable values, keyed by the
+// environment variable key. Write-only attributes are never persisted to the plan or state, so
+// they can only be read from the configuration.
+func writeOnlyEnvironmentValues(ctx context.Context, config tfsdk.Config, p path.Path) (map[string]string, diag.Diagnostics) {
+	var v types.Map
+	diags := config.GetAttribute(ctx, p, &v)
+	if diags.HasError() || v.IsNull() || v.IsUnknown() {
+		return nil, diags
+	}
+	values := map[string]string{}
+	diags.Append(v.ElementsAs(ctx, &values, false)...)
+	return values, diags
+}
+
+// validateWriteOnlyEnvironmentValues ensures that every environment variable without a value has
+// a write-only value. As the write-only values are keyed by the environment variable key, only one
+// environment variable with each key can take its value from them. The environment variables must be
+// in the same order as the set elements.
+func validateWriteOnlyEnvironmentValues(ctx context.Context, config tfsdk.Config, envs []EnvironmentItem, elements []attr.Value, envPath, valuesPath path.Path) diag.Diagnostics {
+	var values types.Map
+	diags := config.GetAttribute(ctx, valuesPath, &values)
+	if diags.HasError() || values.IsUnknown() {
+		return diags
+	}
+	withoutValue := map[string]int{}
+	for _, e := range envs {
+		if e.Value.IsNull() && !e.Key.IsUnknown() {
+			withoutValue[e.Key.ValueString()]++
+		}
+	}
+	for i, e := range envs {
+		if !e.Value.IsNull() || e.Key.IsUnknown() {
+			continue
+		}
+		if withoutValue[e.Key.ValueString()] > 1 {
+			diags.AddAttributeError(
+				envPath.AtSetValue(elements[i]).AtName("value"),
+				"Ambiguous Environment Variable Value",
+				fmt.Sprintf(
+					"There are %d Environment Variables with the key %s that take their value from `%s`, which can only hold one value for each key. Set `value` on all but one of them, or use a separate `vercel_project_environment_variable` resource with `value_wo` for each of them.",
+					withoutValue[e.Key.ValueString()],
+					e.Key.ValueString(),
+					valuesPath,
+				),
+			)
+			continue
+		}
+		if _, ok := values.Elements()[e.Key.ValueString()]; ok {
+			continue
+		}
+		diags.AddAttributeError(
+			envPath.AtSetValue(elements[i]).AtName("value"),
+			"Missing Environment Variable Value",
+			fmt.Sprintf(
+				"The Environment Variable %s does not have a value. Either set `value`, or add %s to `%s`.",
+				e.Key.ValueString(),
+				e.Key.ValueString(),
+				valuesPath,
+			),
+		)
+	}
+	return diags
+}
+
+// requestValue returns the value to send to the API for an environment variable. If no value is
+// configured, the value is taken from the write-only values instead.
+func (e *EnvironmentItem) requestValue(writeOnlyValues map[string]string) string {
+	if e.Value.IsNull() {
+		return writeOnlyValues[e.Key.ValueString()]
+	}
+	return e.Value.ValueString()
+}
+
+// rotateWriteOnlyEnvVars adds any environment variables that take their value from write-only
+// values to the environment variables to create and remove. As the previous value is unknown,
+// this is the only way to ensure the latest value is used when the version changes.
+func rotateWriteOnlyEnvVars(oldVars, newVars, toCreate, toRemove []EnvironmentItem) ([]EnvironmentItem, []EnvironmentItem) {
+	for _, e := range newVars {
+		if e.Value.IsNull() && !containsEnvVar(toCreate, e) {
+			toCreate = append(toCreate, e)
+		}
+	}
+	for _, e := range oldVars {
+		if e.Value.IsNull() && !containsEnvVar(toRemove, e) {
+			toRemove = append(toRemove, e)
+		}
+	}
+	return toCreate, toRemove
+}